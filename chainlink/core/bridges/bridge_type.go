@@ -10,7 +10,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -21,6 +24,14 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// FallbackURLs are tried in order when the request to URL fails.
+	FallbackURLs WebURLs `json:"fallbackURLs"`
+	// HealthCheckPath enables the periodic health checks of the bridge endpoints, relative to their URL.
+	HealthCheckPath     string           `json:"healthCheckPath"`
+	HealthCheckInterval sqlutil.Interval `json:"healthCheckInterval"`
+	// RequestSchema and ResponseSchema are optional JSON Schemas the bridge requests and responses must match.
+	RequestSchema  string `json:"requestSchema"`
+	ResponseSchema string `json:"responseSchema"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +59,11 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	FallbackURLs           WebURLs
+	HealthCheckPath        string
+	HealthCheckInterval    sqlutil.Interval
+	RequestSchema          string
+	ResponseSchema         string
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,10 +76,33 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	FallbackURLs           WebURLs
+	HealthCheckPath        string
+	HealthCheckInterval    sqlutil.Interval
+	RequestSchema          string
+	ResponseSchema         string
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
 
+// Endpoints returns the URLs of the bridge, in failover order.
+func (bt BridgeType) Endpoints() []models.WebURL {
+	return append([]models.WebURL{bt.URL}, bt.FallbackURLs...)
+}
+
+// HealthCheckEnabled returns true if the endpoints of the bridge are periodically checked.
+func (bt BridgeType) HealthCheckEnabled() bool {
+	return bt.HealthCheckPath != ""
+}
+
+// HealthCheckPeriod returns the interval between two health checks of the bridge endpoints.
+func (bt BridgeType) HealthCheckPeriod() time.Duration {
+	if bt.HealthCheckInterval.IsZero() {
+		return DefaultHealthCheckInterval
+	}
+	return bt.HealthCheckInterval.Duration()
+}
+
 // NewBridgeType returns a bridge type authentication (with plaintext
 // password) and a bridge type (with hashed password, for persisting)
 func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
@@ -78,21 +117,31 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		FallbackURLs:           btr.FallbackURLs,
+		HealthCheckPath:        btr.HealthCheckPath,
+		HealthCheckInterval:    btr.HealthCheckInterval,
+		RequestSchema:          btr.RequestSchema,
+		ResponseSchema:         btr.ResponseSchema,
+	}, &BridgeType{
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingTokenHash:      hash,
+		Salt:                   salt,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+		FallbackURLs:           btr.FallbackURLs,
+		HealthCheckPath:        btr.HealthCheckPath,
+		HealthCheckInterval:    btr.HealthCheckInterval,
+		RequestSchema:          btr.RequestSchema,
+		ResponseSchema:         btr.ResponseSchema,
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -200,6 +249,38 @@ func (t *BridgeName) Scan(value any) error {
 	return nil
 }
 
+// WebURLs is a list of URLs stored as a Postgres text array.
+type WebURLs []models.WebURL
+
+// Value returns this instance serialized for database storage.
+func (u WebURLs) Value() (driver.Value, error) {
+	strs := make(pq.StringArray, len(u))
+	for i, webURL := range u {
+		strs[i] = webURL.String()
+	}
+	return strs.Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (u *WebURLs) Scan(value any) error {
+	var strs pq.StringArray
+	if err := strs.Scan(value); err != nil {
+		return err
+	}
+	if len(strs) == 0 {
+		*u = nil
+		return nil
+	}
+	urls := make(WebURLs, len(strs))
+	for i, str := range strs {
+		if err := urls[i].Scan(str); err != nil {
+			return err
+		}
+	}
+	*u = urls
+	return nil
+}
+
 type BridgeResponse struct {
 	DotID      string
 	SpecID     int32
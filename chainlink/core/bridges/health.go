@@ -0,0 +1,209 @@
+package bridges
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	HealthCheckerServiceName = "BridgeHealthChecker"
+	// DefaultHealthCheckInterval is used for bridges with a health check path but no interval.
+	DefaultHealthCheckInterval = 30 * time.Second
+	// MinHealthCheckInterval is the smallest supported interval, it is also how often bridges are considered for a check.
+	MinHealthCheckInterval = 5 * time.Second
+
+	healthCheckTimeout  = 5 * time.Second
+	healthCheckPageSize = 1_000
+)
+
+// EndpointStatus is the result of the last health check of a bridge endpoint.
+type EndpointStatus string
+
+const (
+	// EndpointStatusUnknown is the status of endpoints which were not checked yet, or are not checked at all.
+	EndpointStatusUnknown   EndpointStatus = "unknown"
+	EndpointStatusHealthy   EndpointStatus = "healthy"
+	EndpointStatusUnhealthy EndpointStatus = "unhealthy"
+)
+
+// EndpointHealth is the health of a single bridge endpoint.
+type EndpointHealth struct {
+	URL       string         `json:"url"`
+	Status    EndpointStatus `json:"status"`
+	Error     string         `json:"error,omitempty"`
+	CheckedAt *time.Time     `json:"checkedAt,omitempty"`
+}
+
+// HealthChecker periodically checks the endpoints of the bridges which have a health check path, and orders
+// their endpoints so that unhealthy ones are only used as a last resort.
+//
+// A nil *HealthChecker is valid and reports every endpoint with an unknown status.
+type HealthChecker struct {
+	services.Service
+	eng *services.Engine
+
+	orm        ORM
+	httpClient *http.Client
+
+	mu          sync.RWMutex
+	endpoints   map[BridgeName]map[string]EndpointHealth
+	lastChecked map[BridgeName]time.Time
+}
+
+func NewHealthChecker(orm ORM, httpClient *http.Client, lggr logger.Logger) *HealthChecker {
+	h := &HealthChecker{
+		orm:         orm,
+		httpClient:  httpClient,
+		endpoints:   make(map[BridgeName]map[string]EndpointHealth),
+		lastChecked: make(map[BridgeName]time.Time),
+	}
+	h.Service, h.eng = services.Config{
+		Name:  HealthCheckerServiceName,
+		Start: h.start,
+	}.NewServiceEngine(lggr)
+	return h
+}
+
+func (h *HealthChecker) start(_ context.Context) error {
+	h.eng.GoTick(services.NewTicker(MinHealthCheckInterval), h.checkAll)
+	return nil
+}
+
+// Health returns the health of the endpoints of bt, in failover order.
+func (h *HealthChecker) Health(bt BridgeType) []EndpointHealth {
+	endpoints := bt.Endpoints()
+	health := make([]EndpointHealth, len(endpoints))
+	if h != nil {
+		h.mu.RLock()
+		defer h.mu.RUnlock()
+	}
+	for i, endpoint := range endpoints {
+		u := endpoint.String()
+		health[i] = EndpointHealth{URL: u, Status: EndpointStatusUnknown}
+		if h == nil || !bt.HealthCheckEnabled() {
+			continue
+		}
+		if eh, ok := h.endpoints[bt.Name][u]; ok {
+			health[i] = eh
+		}
+	}
+	return health
+}
+
+// Endpoints returns the endpoints of bt in the order they should be tried: the endpoints which are not known
+// to be unhealthy first, then the unhealthy ones, both in failover order.
+func (h *HealthChecker) Endpoints(bt BridgeType) []models.WebURL {
+	endpoints := bt.Endpoints()
+	var available, unhealthy []models.WebURL
+	for i, eh := range h.Health(bt) {
+		if eh.Status == EndpointStatusUnhealthy {
+			unhealthy = append(unhealthy, endpoints[i])
+		} else {
+			available = append(available, endpoints[i])
+		}
+	}
+	return append(available, unhealthy...)
+}
+
+func (h *HealthChecker) checkAll(ctx context.Context) {
+	var all []BridgeType
+	for offset := 0; ; offset += healthCheckPageSize {
+		bts, _, err := h.orm.BridgeTypes(ctx, offset, healthCheckPageSize)
+		if err != nil {
+			h.eng.Warnw("Failed to load bridges for health checks", "err", err)
+			return
+		}
+		all = append(all, bts...)
+		if len(bts) < healthCheckPageSize {
+			break
+		}
+	}
+
+	now := time.Now()
+	var due []BridgeType
+	h.mu.Lock()
+	configured := make(map[BridgeName]struct{}, len(all))
+	for _, bt := range all {
+		if !bt.HealthCheckEnabled() {
+			continue
+		}
+		configured[bt.Name] = struct{}{}
+		if last, ok := h.lastChecked[bt.Name]; ok && now.Sub(last) < bt.HealthCheckPeriod() {
+			continue
+		}
+		h.lastChecked[bt.Name] = now
+		due = append(due, bt)
+	}
+	// forget the bridges which were deleted or had their health checks disabled
+	for name := range h.lastChecked {
+		if _, ok := configured[name]; !ok {
+			delete(h.lastChecked, name)
+			delete(h.endpoints, name)
+		}
+	}
+	h.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, bt := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.checkBridge(ctx, bt)
+		}()
+	}
+	wg.Wait()
+}
+
+func (h *HealthChecker) checkBridge(ctx context.Context, bt BridgeType) {
+	endpoints := bt.Endpoints()
+	health := make(map[string]EndpointHealth, len(endpoints))
+	for _, endpoint := range endpoints {
+		checkedAt := time.Now()
+		eh := EndpointHealth{URL: endpoint.String(), Status: EndpointStatusHealthy, CheckedAt: &checkedAt}
+		if err := h.checkEndpoint(ctx, endpoint, bt.HealthCheckPath); err != nil {
+			eh.Status = EndpointStatusUnhealthy
+			eh.Error = err.Error()
+			h.eng.Debugw("Bridge endpoint is unhealthy", "bridge", bt.Name, "url", eh.URL, "err", err)
+		}
+		health[eh.URL] = eh
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// the bridge may have been forgotten while it was checked
+	if _, ok := h.lastChecked[bt.Name]; ok {
+		h.endpoints[bt.Name] = health
+	}
+}
+
+func (h *HealthChecker) checkEndpoint(ctx context.Context, endpoint models.WebURL, healthCheckPath string) error {
+	u := url.URL(endpoint)
+	u.Path = path.Join(u.Path, healthCheckPath)
+	u.RawPath = ""
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+	return nil
+}
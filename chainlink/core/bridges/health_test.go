@@ -0,0 +1,81 @@
+package bridges_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestHealthChecker(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(status int) *httptest.Server {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/adapter/health" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(status)
+		}))
+		t.Cleanup(s.Close)
+		return s
+	}
+	down := newServer(http.StatusServiceUnavailable)
+	up := newServer(http.StatusOK)
+
+	checked := bridges.BridgeType{
+		Name:            "checked",
+		URL:             cltest.WebURL(t, down.URL+"/adapter"),
+		FallbackURLs:    bridges.WebURLs{cltest.WebURL(t, up.URL+"/adapter")},
+		HealthCheckPath: "/health",
+	}
+	unchecked := bridges.BridgeType{
+		Name:         "unchecked",
+		URL:          cltest.WebURL(t, down.URL+"/adapter"),
+		FallbackURLs: bridges.WebURLs{cltest.WebURL(t, up.URL+"/adapter")},
+	}
+
+	mORM := mocks.NewORM(t)
+	mORM.On("BridgeTypes", mock.Anything, 0, mock.Anything).Return([]bridges.BridgeType{checked, unchecked}, 2, nil)
+	h := bridges.NewHealthChecker(mORM, http.DefaultClient, logger.TestLogger(t))
+	require.NoError(t, h.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, h.Close()) })
+
+	require.Eventually(t, func() bool {
+		return h.Health(checked)[0].Status != bridges.EndpointStatusUnknown
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	health := h.Health(checked)
+	require.Len(t, health, 2)
+	assert.Equal(t, bridges.EndpointStatusUnhealthy, health[0].Status)
+	assert.Equal(t, "health check returned status 503", health[0].Error)
+	assert.NotNil(t, health[0].CheckedAt)
+	assert.Equal(t, bridges.EndpointStatusHealthy, health[1].Status)
+	// the unhealthy endpoint is only used as a last resort
+	assert.Equal(t, []models.WebURL{checked.FallbackURLs[0], checked.URL}, h.Endpoints(checked))
+
+	for _, eh := range h.Health(unchecked) {
+		assert.Equal(t, bridges.EndpointStatusUnknown, eh.Status)
+	}
+	assert.Equal(t, unchecked.Endpoints(), h.Endpoints(unchecked))
+
+	t.Run("nil health checker", func(t *testing.T) {
+		var nilChecker *bridges.HealthChecker
+		assert.Equal(t, checked.Endpoints(), nilChecker.Endpoints(checked))
+		for _, eh := range nilChecker.Health(checked) {
+			assert.Equal(t, bridges.EndpointStatusUnknown, eh.Status)
+		}
+	})
+}
@@ -101,6 +101,7 @@ func (o *orm) DeleteBridgeType(ctx context.Context, bt *BridgeType) error {
 	if rowsAffected == 0 {
 		return sql.ErrNoRows
 	}
+	forgetSchemas(bt.Name)
 
 	return nil
 }
@@ -124,8 +125,8 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, fallback_urls, health_check_path, health_check_interval, request_schema, response_schema, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :fallback_urls, :health_check_path, :health_check_interval, :request_schema, :response_schema, now(), now())
 	RETURNING *;`
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
@@ -141,8 +142,9 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	stmt := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, fallback_urls = $4, health_check_path = $5, health_check_interval = $6, request_schema = $7, response_schema = $8
+	WHERE name = $9 RETURNING *`
+	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, btr.FallbackURLs, btr.HealthCheckPath, btr.HealthCheckInterval, btr.RequestSchema, btr.ResponseSchema, bt.Name)
 
 	return err
 }
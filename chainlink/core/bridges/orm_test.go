@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
@@ -115,7 +116,11 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.NoError(t, orm.CreateBridgeType(ctx, firstBridge))
 
 	updateBridge := &bridges.BridgeTypeRequest{
-		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		URL:                 cltest.WebURL(t, "http:/updatedurl.com"),
+		FallbackURLs:        bridges.WebURLs{cltest.WebURL(t, "http:/fallbackurl.com"), cltest.WebURL(t, "http:/otherfallbackurl.com")},
+		HealthCheckPath:     "/health",
+		HealthCheckInterval: *sqlutil.NewInterval(time.Minute),
+		ResponseSchema:      `{"type": "object"}`,
 	}
 
 	require.NoError(t, orm.UpdateBridgeType(ctx, firstBridge, updateBridge))
@@ -123,6 +128,12 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := orm.FindBridge(ctx, "UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, updateBridge.FallbackURLs, foundbridge.FallbackURLs)
+	require.Equal(t, "/health", foundbridge.HealthCheckPath)
+	require.Equal(t, time.Minute, foundbridge.HealthCheckInterval.Duration())
+	require.Empty(t, foundbridge.RequestSchema)
+	require.Equal(t, `{"type": "object"}`, foundbridge.ResponseSchema)
+	require.Equal(t, append([]models.WebURL{updateBridge.URL}, updateBridge.FallbackURLs...), foundbridge.Endpoints())
 
 	bs, count, err := orm.BridgeTypes(ctx, 0, 10)
 	require.NoError(t, err)
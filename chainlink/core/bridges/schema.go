@@ -0,0 +1,101 @@
+package bridges
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const schemaURL = "mem://bridge/schema.json"
+
+// compiledSchemas caches the compiled schemas of each bridge, since bridges are called far more often than
+// their schemas change. There is one entry per bridge and kind of schema, replaced when the schema of the
+// bridge is updated and removed when the bridge is deleted, see forgetSchemas.
+var compiledSchemas = struct {
+	sync.Mutex
+	byKey map[schemaKey]compiledSchema
+}{byKey: map[schemaKey]compiledSchema{}}
+
+type schemaKey struct {
+	bridge   BridgeName
+	response bool
+}
+
+type compiledSchema struct {
+	source string
+	schema *jsonschema.Schema
+}
+
+// ValidateSchema returns an error if schema is not a valid JSON Schema.
+func ValidateSchema(schema string) error {
+	_, err := compileSchema(schema)
+	return err
+}
+
+// ValidateRequest validates a request body against the request schema of the bridge, if any.
+func (bt BridgeType) ValidateRequest(body []byte) error {
+	return validateAgainstSchema(schemaKey{bridge: bt.Name}, bt.RequestSchema, body)
+}
+
+// ValidateResponse validates a response body against the response schema of the bridge, if any.
+func (bt BridgeType) ValidateResponse(body []byte) error {
+	return validateAgainstSchema(schemaKey{bridge: bt.Name, response: true}, bt.ResponseSchema, body)
+}
+
+func validateAgainstSchema(key schemaKey, schema string, body []byte) error {
+	if schema == "" {
+		return nil
+	}
+	s, err := cachedSchema(key, schema)
+	if err != nil {
+		return errors.Wrap(err, "invalid schema")
+	}
+	var payload any
+	if err = json.Unmarshal(body, &payload); err != nil {
+		return errors.Wrap(err, "body is not valid JSON")
+	}
+	return s.Validate(payload)
+}
+
+// cachedSchema returns the compiled schema of key, compiling it again if its source has changed.
+func cachedSchema(key schemaKey, schema string) (*jsonschema.Schema, error) {
+	compiledSchemas.Lock()
+	cached, ok := compiledSchemas.byKey[key]
+	compiledSchemas.Unlock()
+	if ok && cached.source == schema {
+		return cached.schema, nil
+	}
+	s, err := compileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	compiledSchemas.Lock()
+	compiledSchemas.byKey[key] = compiledSchema{source: schema, schema: s}
+	compiledSchemas.Unlock()
+	return s, nil
+}
+
+// forgetSchemas removes the compiled schemas of a deleted bridge from the cache.
+func forgetSchemas(name BridgeName) {
+	compiledSchemas.Lock()
+	defer compiledSchemas.Unlock()
+	delete(compiledSchemas.byKey, schemaKey{bridge: name})
+	delete(compiledSchemas.byKey, schemaKey{bridge: name, response: true})
+}
+
+// compileSchema compiles a schema, refusing to resolve any $ref outside of the schema itself so that bridges
+// cannot make the node read local files or fetch remote documents.
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, errors.Errorf("loading external schema %s is not allowed", url)
+	}
+	if err := c.AddResource(schemaURL, strings.NewReader(schema)); err != nil {
+		return nil, err
+	}
+	return c.Compile(schemaURL)
+}
@@ -0,0 +1,52 @@
+package bridges_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+func TestBridgeType_ValidateResponse(t *testing.T) {
+	t.Parallel()
+
+	bt := bridges.BridgeType{
+		RequestSchema:  `{"type": "object", "required": ["data"]}`,
+		ResponseSchema: `{"type": "object", "required": ["result"], "properties": {"result": {"type": "number"}}}`,
+	}
+
+	require.NoError(t, bt.ValidateRequest([]byte(`{"data": {}}`)))
+	require.Error(t, bt.ValidateRequest([]byte(`{}`)))
+
+	require.NoError(t, bt.ValidateResponse([]byte(`{"result": 1.5}`)))
+	err := bt.ValidateResponse([]byte(`{"result": "1.5"}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected number")
+	err = bt.ValidateResponse([]byte(`not json`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "body is not valid JSON")
+
+	t.Run("no schema", func(t *testing.T) {
+		assert.NoError(t, bridges.BridgeType{}.ValidateResponse([]byte(`not json`)))
+	})
+
+	t.Run("updated schema", func(t *testing.T) {
+		updated := bridges.BridgeType{Name: "updated", ResponseSchema: `{"type": "object", "required": ["result"]}`}
+		require.Error(t, updated.ValidateResponse([]byte(`{"data": 1}`)))
+		updated.ResponseSchema = `{"type": "object", "required": ["data"]}`
+		require.NoError(t, updated.ValidateResponse([]byte(`{"data": 1}`)))
+	})
+}
+
+func TestValidateSchema(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, bridges.ValidateSchema(`{"type": "object"}`))
+	assert.Error(t, bridges.ValidateSchema(`{"type": 1}`))
+	assert.Error(t, bridges.ValidateSchema(`not json`))
+	err := bridges.ValidateSchema(`{"$ref": "file:///etc/passwd"}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not allowed")
+}
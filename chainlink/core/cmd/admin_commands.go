@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -111,7 +114,7 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 						},
 						cli.StringFlag{
 							Name:     "new-role, newrole",
-							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+							Usage:    "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view' or the name of a custom role.",
 							Required: true,
 						},
 					},
@@ -130,6 +133,177 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "roles",
+			Usage: "Create, edit, or delete custom roles which can be assigned to API users with 'users chrole'",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists all custom roles and their permissions",
+					Action: s.ListRoles,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new custom role",
+					Action: s.CreateRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new role",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "permission, p",
+							Usage: "Permission granted by the role, of the form resource:action[:scope], e.g. 'bridges:edit' or 'jobs:run:<external job ID>'. Can be repeated.",
+						},
+					},
+				},
+				{
+					Name:   "update",
+					Usage:  "Replace the permissions of a custom role",
+					Action: s.UpdateRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the role to update",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "permission, p",
+							Usage: "Permission granted by the role, of the form resource:action[:scope]. Can be repeated.",
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a custom role which is not assigned to any API user",
+					Action: s.DeleteRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the role to delete",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "audit",
+			Usage: "Query and verify the audit events persisted by the local sink of the audit logger",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists the persisted audit events, most recent first",
+					Action: s.ListAuditEvents,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "event-id",
+							Usage: "Only list events with this event ID, e.g. 'API_TOKEN_CREATED'",
+						},
+						cli.StringFlag{
+							Name:  "user",
+							Usage: "Only list events triggered by the user with this email",
+						},
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "Only list events created at or after this RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "to",
+							Usage: "Only list events created before this RFC3339 time",
+						},
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:   "verify",
+					Usage:  "Checks the integrity of the hash chain of the persisted audit events",
+					Action: s.VerifyAuditEvents,
+				},
+			},
+		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or revoke your named API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists your named API tokens, including expired ones",
+					Action: s.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new named API token, its secret is only displayed once",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new token, unique among your tokens",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "expires-in",
+							Usage: "Lifetime of the token, e.g. '24h'. Defaults to 30 days.",
+						},
+						cli.StringFlag{
+							Name:  "role",
+							Usage: "Optional built-in role to downgrade the token to. Options: 'admin', 'edit', 'run', 'view'.",
+						},
+						cli.StringSliceFlag{
+							Name:  "scope, s",
+							Usage: "Optional permission the token is restricted to, of the form resource:action[:scope], e.g. 'jobs:view'. Can be repeated.",
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke one of your named API tokens",
+					Action: s.RevokeAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the token to revoke",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "keystore",
+			Usage: "Manage the keystore of the node",
+			Subcommands: cli.Commands{
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypt the keystore with a new password and optionally new scrypt parameters. Update the keystore password in the secrets before restarting the node.",
+					Action: s.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "old-password",
+							Usage:    "`FILE` containing the current keystore password",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "new-password",
+							Usage:    "`FILE` containing the new keystore password",
+							Required: true,
+						},
+						cli.IntFlag{
+							Name:  "scrypt-n",
+							Usage: "scrypt CPU/memory cost parameter N, a power of 2 of at most 1048576. Defaults to the current one.",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-p",
+							Usage: "scrypt parallelization parameter P, at most 16. Defaults to the current one.",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -141,9 +315,13 @@ type AdminUsersPresenter struct {
 var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created at", "Updated at"}
 
 func (p *AdminUsersPresenter) ToRow() []string {
+	role := string(p.Role)
+	if p.CustomRole != "" {
+		role = p.CustomRole
+	}
 	row := []string{
 		p.ID,
-		string(p.Role),
+		role,
 		p.HasActiveApiToken,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
@@ -301,6 +479,344 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type AdminRolePresenter struct {
+	JAID
+	presenters.RoleResource
+}
+
+var adminRolesTableHeaders = []string{"Name", "Permissions", "Created at", "Updated at"}
+
+func (p *AdminRolePresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		strings.Join(p.Permissions, "\n"),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminRolePresenter) RenderTable(rt RendererTable) error {
+	renderList(adminRolesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminRolePresenters []AdminRolePresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminRolePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Roles\n")); err != nil {
+		return err
+	}
+	renderList(adminRolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRoles renders all custom roles and their permissions
+func (s *Shell) ListRoles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminRolePresenters{})
+}
+
+// CreateRole creates a new custom role with the given permissions
+func (s *Shell) CreateRole(c *cli.Context) (err error) {
+	permissions := c.StringSlice("permission")
+	if _, err = sessions.ParsePermissions(permissions); err != nil {
+		return s.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(web.RoleRequest{
+		Name:        c.String("name"),
+		Permissions: permissions,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/roles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully created new role")
+}
+
+// UpdateRole replaces the permissions of a custom role
+func (s *Shell) UpdateRole(c *cli.Context) (err error) {
+	permissions := c.StringSlice("permission")
+	if _, err = sessions.ParsePermissions(permissions); err != nil {
+		return s.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(web.RoleRequest{
+		Permissions: permissions,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Patch(s.ctx(), "/v2/roles/"+c.String("name"), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully updated role")
+}
+
+// DeleteRole deletes a custom role by name
+func (s *Shell) DeleteRole(c *cli.Context) (err error) {
+	response, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+c.String("name"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully deleted role")
+}
+
+type AdminAPITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var adminAPITokensTableHeaders = []string{"Name", "Role", "Scope", "Expires at", "Last used at", "Created at"}
+
+func (p *AdminAPITokenPresenter) ToRow() []string {
+	lastUsedAt := ""
+	if p.LastUsedAt != nil {
+		lastUsedAt = p.LastUsedAt.String()
+	}
+	return []string{
+		p.Name,
+		p.Role,
+		strings.Join(p.Scope, "\n"),
+		p.ExpiresAt.String(),
+		lastUsedAt,
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminAPITokenPresenter) RenderTable(rt RendererTable) error {
+	headers, row := adminAPITokensTableHeaders, p.ToRow()
+	if p.Secret != "" {
+		headers = append(append([]string{}, headers...), "Access key", "Secret")
+		row = append(row, p.AccessKey, p.Secret)
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminAPITokenPresenters []AdminAPITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminAPITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(adminAPITokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the named API tokens of the logged in user
+func (s *Shell) ListAPITokens(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/user/tokens", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminAPITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the logged in user
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	scope := c.StringSlice("scope")
+	if _, err = sessions.ParsePermissions(scope); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Println("Password of your user:")
+	pwd := s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(sessions.CreateAPITokenRequest{
+		Password:  pwd,
+		Name:      c.String("name"),
+		ExpiresIn: c.String("expires-in"),
+		Role:      c.String("role"),
+		Scope:     scope,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminAPITokenPresenter{}, "Successfully created new API token, its secret will not be displayed again")
+}
+
+// RevokeAPIToken revokes a named API token of the logged in user
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	response, err := s.HTTP.Delete(s.ctx(), "/v2/user/tokens/"+c.String("name"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	if response.StatusCode == http.StatusNoContent {
+		fmt.Printf("Successfully revoked API token %s\n", c.String("name"))
+		return nil
+	}
+	return s.renderAPIResponse(response, &AdminAPITokenPresenter{})
+}
+
+type AuditEventPresenter struct {
+	JAID
+	presenters.AuditEventResource
+}
+
+var auditEventsTableHeaders = []string{"ID", "Event ID", "User", "Data", "Created at", "Hash"}
+
+func (p *AuditEventPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		string(p.EventID),
+		p.User,
+		string(p.Data),
+		p.CreatedAt.String(),
+		p.Hash,
+	}
+}
+
+type AuditEventPresenters []AuditEventPresenter
+
+// RenderTable implements TableRenderer
+func (ps AuditEventPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Audit events\n")); err != nil {
+		return err
+	}
+	renderList(auditEventsTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AuditVerifyPresenter struct {
+	JAID
+	presenters.AuditVerifyResource
+}
+
+// RenderTable implements TableRenderer
+func (p *AuditVerifyPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Valid", "Events", "Last hash"}
+	row := []string{strconv.FormatBool(p.Valid), strconv.FormatInt(p.Events, 10), p.LastHash}
+	if !p.Valid {
+		headers = append(headers, "First invalid ID", "Reason")
+		row = append(row, strconv.FormatInt(p.FirstInvalidID, 10), p.Reason)
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAuditEvents renders the persisted audit events matching the filters
+func (s *Shell) ListAuditEvents(c *cli.Context) (err error) {
+	query := url.Values{}
+	for flag, param := range map[string]string{"event-id": "eventID", "user": "user", "from": "from", "to": "to"} {
+		if v := c.String(flag); v != "" {
+			query.Set(param, v)
+		}
+	}
+	uri := "/v2/audit"
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &AuditEventPresenters{})
+}
+
+// VerifyAuditEvents checks the integrity of the hash chain of the persisted audit events, and fails if it was
+// tampered with
+func (s *Shell) VerifyAuditEvents(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/audit/verify")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	var result AuditVerifyPresenter
+	if err = s.renderAPIResponse(resp, &result); err != nil {
+		return err
+	}
+	if !result.Valid {
+		return s.errorOut(fmt.Errorf("audit event hash chain is broken at event %d: %s", result.FirstInvalidID, result.Reason))
+	}
+	return nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -418,3 +934,45 @@ func (s *Shell) Profile(c *cli.Context) error {
 	}
 	return nil
 }
+
+// RotateKeystorePassword re-encrypts the keystore of the node with a new password
+func (s *Shell) RotateKeystorePassword(c *cli.Context) (err error) {
+	oldPassword, err := os.ReadFile(c.String("old-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("could not read old password file: %w", err))
+	}
+	newPassword, err := os.ReadFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("could not read new password file: %w", err))
+	}
+
+	requestData, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		OldPassword: strings.TrimSpace(string(oldPassword)),
+		NewPassword: strings.TrimSpace(string(newPassword)),
+		ScryptN:     c.Int("scrypt-n"),
+		ScryptP:     c.Int("scrypt-p"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/keystore/rotate-password", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	switch response.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated, update it in the secrets before restarting the node.")
+	case http.StatusConflict:
+		fmt.Println("Old password did not match.")
+	default:
+		return s.printResponseBody(response)
+	}
+	return nil
+}
@@ -165,6 +165,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for the node's configuration",
 			Subcommands: initRemoteConfigSubCmds(s),
 		},
+		{
+			Name:        "fragments",
+			Usage:       "Commands for managing reusable pipeline fragments",
+			Subcommands: initPipelineFragmentSubCmds(s),
+		},
 		{
 			Name:   "health",
 			Usage:  "Prints a health report",
@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/urfave/cli"
 
@@ -49,6 +50,15 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyEndpoints summarizes the health of the bridge endpoints, in failover order
+func (p *BridgePresenter) FriendlyEndpoints() string {
+	statuses := make([]string, len(p.Endpoints))
+	for i, endpoint := range p.Endpoints {
+		statuses[i] = string(endpoint.Status)
+	}
+	return strings.Join(statuses, ", ")
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
 	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
@@ -59,6 +69,24 @@ func (p *BridgePresenter) RenderTable(rt RendererTable) error {
 		p.OutgoingToken,
 	})
 	render("Bridge", table)
+
+	if len(p.Endpoints) == 0 {
+		return nil
+	}
+	endpointsTable := rt.newTable([]string{"URL", "Status", "Checked At", "Error"})
+	for _, endpoint := range p.Endpoints {
+		var checkedAt string
+		if endpoint.CheckedAt != nil {
+			checkedAt = endpoint.CheckedAt.String()
+		}
+		endpointsTable.Append([]string{
+			endpoint.URL,
+			string(endpoint.Status),
+			checkedAt,
+			endpoint.Error,
+		})
+	}
+	render("Endpoints", endpointsTable)
 	return nil
 }
 
@@ -66,12 +94,13 @@ type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Endpoints"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			p.FriendlyEndpoints(),
 		})
 	}
 
@@ -24,6 +24,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	var (
 		name          = "Bridge 1"
 		url           = "http://example.com"
+		fallbackURL   = "http://fallback.example.com"
 		createdAt     = time.Now()
 		outgoingToken = "anoutgoingtoken"
 		buffer        = bytes.NewBufferString("")
@@ -38,6 +39,10 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
 			CreatedAt:     createdAt,
+			Endpoints: []bridges.EndpointHealth{
+				{URL: url, Status: bridges.EndpointStatusUnhealthy, Error: "health check returned status 503", CheckedAt: &createdAt},
+				{URL: fallbackURL, Status: bridges.EndpointStatusHealthy, CheckedAt: &createdAt},
+			},
 		},
 	}
 
@@ -49,6 +54,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, fallbackURL)
+	assert.Contains(t, output, "health check returned status 503")
 
 	// Render many resources
 	buffer.Reset()
@@ -60,6 +67,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.NotContains(t, output, outgoingToken)
+	assert.Contains(t, output, "unhealthy, healthy")
 }
 
 func TestShell_IndexBridges(t *testing.T) {
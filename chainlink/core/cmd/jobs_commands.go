@@ -6,11 +6,20 @@ import (
 	stderrors "errors"
 	"fmt"
 	"io"
+	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/urfave/cli"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -39,17 +48,125 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Name:   "create",
 			Usage:  "Create a job",
 			Action: s.CreateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "comment",
+					Usage: "comment recorded with the first revision of the job spec",
+				},
+			},
+		},
+		{
+			Name:   "validate",
+			Usage:  "Validate a job spec against the node without creating the job",
+			Action: s.ValidateJob,
 		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Pause a job, stopping its services without deleting it",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume a paused job",
+			Action: s.ResumeJob,
+		},
+		{
+			Name:   "history",
+			Usage:  "List the spec revisions of a job",
+			Action: s.JobHistory,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the difference between two spec revisions of a job",
+			Action: s.DiffJobRevisions,
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Replace a job with one of its previous spec revisions",
+			Action: s.RollbackJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "comment",
+					Usage: "comment recorded with the new revision, defaults to 'rollback to revision <rev>'",
+				},
+			},
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:  "runs",
+			Usage: "Export, replay and retry pipeline runs",
+			Subcommands: cli.Commands{
+				{
+					Name:   "export",
+					Usage:  "Export a pipeline run with the inputs and outputs of its tasks to a JSON bundle",
+					Action: s.ExportPipelineRun,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "path to write the bundle to, instead of stdout",
+						},
+					},
+				},
+				{
+					Name:   "replay",
+					Usage:  "Replay an exported pipeline run against the current spec of its job and show how each task result differs",
+					Action: s.ReplayPipelineRun,
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{
+							Name:  "recorded",
+							Usage: "DOT ID of a task which uses its recorded output instead of being executed, may be repeated",
+						},
+					},
+				},
+				{
+					Name:   "retry",
+					Usage:  "Retry a run of a job which finished with errors, with the vars of the original run",
+					Action: s.RetryPipelineRun,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "skip-succeeded",
+							Usage: "reuse the results of the tasks which succeeded in the original run instead of executing them again",
+						},
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: "JSON object of pipeline variables merged into the vars of the original run, e.g. '{\"jobRun\": {\"requestBody\": \"...\"}}'",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Execute the observationSource of a job spec locally, substituting http, bridge and ethcall results from fixtures",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "path to the JSON fixtures file",
+				},
+				cli.BoolFlag{
+					Name:  "record",
+					Usage: "execute http and bridge tasks missing from the fixtures and save their results to the fixtures file",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline variables, e.g. '{\"jobRun\": {\"requestBody\": \"...\"}}'",
+				},
+				cli.StringFlag{
+					Name:  "expect",
+					Usage: "path to a JSON file of previously simulated task results; exits with an error if any task result differs",
+				},
+			},
+		},
 	}
 }
 
@@ -176,6 +293,10 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.CCIPSpec != nil {
 			return p.CCIPSpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.LogTriggerJobSpec:
+		if p.LogTriggerSpec != nil {
+			return p.LogTriggerSpec.CreatedAt.Format(time.RFC3339)
+		}
 	default:
 		return "unknown"
 	}
@@ -250,7 +371,8 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	}
 
 	request, err := json.Marshal(web.CreateJobRequest{
-		TOML: tomlString,
+		TOML:    tomlString,
+		Comment: c.String("comment"),
 	})
 	if err != nil {
 		return s.errorOut(err)
@@ -280,6 +402,71 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// JobSpecValidationPresenter wraps the JSONAPI job spec validation resource and adds rendering functionality
+type JobSpecValidationPresenter struct {
+	JAID
+	presenters.JobSpecValidationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecValidationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Stage", "Line", "Column", "Message"})
+	for _, e := range p.Errors {
+		table.Append([]string{e.Stage, positionString(e.Line), positionString(e.Column), e.Message})
+	}
+
+	if p.Valid {
+		render(fmt.Sprintf("Job spec (%s) is valid", p.Type), table)
+	} else {
+		render(fmt.Sprintf("Job spec (%s) is invalid", p.Type), table)
+	}
+	return nil
+}
+
+func positionString(pos int) string {
+	if pos == 0 {
+		return ""
+	}
+	return strconv.Itoa(pos)
+}
+
+// ValidateJob runs the validation of job creation against a TOML spec without creating the job.
+// Valid input is a TOML string or a path to TOML file. It returns an error when the spec is invalid.
+func (s *Shell) ValidateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreateJobRequest{TOML: tomlString})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/validate", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var result JobSpecValidationPresenter
+	if err = s.renderAPIResponse(resp, &result); err != nil {
+		return err
+	}
+	if !result.Valid {
+		return s.errorOut(errors.Errorf("job spec has %d error(s)", len(result.Errors)))
+	}
+	return nil
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -298,6 +485,163 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job without deleting it
+func (s *Shell) PauseJob(c *cli.Context) error {
+	return s.setJobState(c, "pause")
+}
+
+// ResumeJob restarts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) error {
+	return s.setJobState(c, "resume")
+}
+
+func (s *Shell) setJobState(c *cli.Context, action string) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.Errorf("must pass the job id to %s", action))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{})
+}
+
+// JobSpecRevisionPresenter wraps the JSONAPI job spec revision resource and adds rendering functionality
+type JobSpecRevisionPresenter struct {
+	JAID
+	presenters.JobSpecRevisionResource
+}
+
+func (p JobSpecRevisionPresenter) toRow() []string {
+	return []string{
+		p.GetID(),
+		p.Author,
+		p.Comment,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecRevisionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Revision", "Author", "Comment", "Created At"})
+	table.Append(p.toRow())
+
+	render("Job Spec Revision", table)
+	return nil
+}
+
+type JobSpecRevisionPresenters []JobSpecRevisionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecRevisionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Revision", "Author", "Comment", "Created At"})
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+
+	render("Job Spec Revisions", table)
+	return nil
+}
+
+// JobHistory lists the accepted spec revisions of a job, newest first
+func (s *Shell) JobHistory(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/revisions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecRevisionPresenters{})
+}
+
+// DiffJobRevisions prints a unified diff between the TOML of two spec revisions of a job
+func (s *Shell) DiffJobRevisions(c *cli.Context) error {
+	if c.NArg() != 3 {
+		return s.errorOut(errors.New("must pass the job id and two revisions"))
+	}
+	id := c.Args().Get(0)
+	from, err := s.fetchJobSpecRevision(id, c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	to, err := s.fetchJobSpecRevision(id, c.Args().Get(2))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.TOML),
+		B:        difflib.SplitLines(to.TOML),
+		FromFile: fmt.Sprintf("revision %d", from.Revision),
+		ToFile:   fmt.Sprintf("revision %d", to.Revision),
+		Context:  3,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Print(diff)
+	return nil
+}
+
+func (s *Shell) fetchJobSpecRevision(id, revision string) (rev JobSpecRevisionPresenter, err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+id+"/revisions/"+revision)
+	if err != nil {
+		return rev, err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	err = s.deserializeAPIResponse(resp, &rev, &jsonapi.Links{})
+	return rev, errors.Wrapf(err, "failed to fetch revision %s of job %s", revision, id)
+}
+
+// RollbackJob replaces a job with the TOML of one of its previous spec revisions
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the revision to roll back to"))
+	}
+	revision, err := strconv.ParseInt(c.Args().Get(1), 10, 32)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid revision"))
+	}
+
+	request, err := json.Marshal(web.RollbackJobRequest{
+		Revision: int32(revision),
+		Comment:  c.String("comment"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/rollback", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job rolled back")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -317,3 +661,268 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunBundlePresenter wraps the JSONAPI pipeline run bundle resource
+type PipelineRunBundlePresenter struct {
+	JAID
+	pipeline.RunBundle
+}
+
+// ExportPipelineRun writes a pipeline run with the inputs and outputs of its tasks to a JSON bundle, which
+// can be replayed with ReplayPipelineRun
+func (s *Shell) ExportPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the pipeline run"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/export")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var bundle PipelineRunBundlePresenter
+	if err = s.deserializeAPIResponse(resp, &bundle, &jsonapi.Links{}); err != nil {
+		return s.errorOut(err)
+	}
+	b, err := json.MarshalIndent(bundle.RunBundle, "", "  ")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if output := c.String("output"); output != "" {
+		return s.errorOut(os.WriteFile(output, b, 0600))
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+// PipelineRunReplayPresenter wraps the JSONAPI pipeline run replay resource and adds rendering functionality
+type PipelineRunReplayPresenter struct {
+	JAID
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Status", "Recorded Output", "Replayed Output"})
+	for _, task := range p.Tasks {
+		name := task.DotID
+		if task.UsedRecorded {
+			name += " (recorded)"
+		}
+		table.Append([]string{
+			name,
+			string(task.Type),
+			string(task.Status),
+			friendlyReplayResult(task.RecordedOutput, task.RecordedError),
+			friendlyReplayResult(task.ReplayedOutput, task.ReplayedError),
+		})
+	}
+
+	render(fmt.Sprintf("Replay of Pipeline Run %s (%s)", p.GetID(), p.State), table)
+	return nil
+}
+
+func friendlyReplayResult(output jsonserializable.JSONSerializable, errStr null.String) string {
+	if errStr.Valid {
+		return "error: " + errStr.String
+	}
+	if !output.Valid {
+		return ""
+	}
+	b, err := json.Marshal(output)
+	if err != nil {
+		return fmt.Sprintf("%v", output.Val)
+	}
+	return string(b)
+}
+
+// ReplayPipelineRun re-executes a pipeline run exported with ExportPipelineRun against the current spec of
+// its job, and shows how the result of each task differs from the recorded one
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path to the run bundle"))
+	}
+	b, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to read run bundle"))
+	}
+	var bundle pipeline.RunBundle
+	if err = json.Unmarshal(b, &bundle); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse run bundle"))
+	}
+
+	request, err := json.Marshal(web.ReplayPipelineRunRequest{
+		Bundle:        bundle,
+		RecordedTasks: c.StringSlice("recorded"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/replay", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+}
+
+// RetryPipelineRun starts a new run of a job from the vars of one of its runs which finished with errors
+func (s *Shell) RetryPipelineRun(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the job id and the id of the run to retry"))
+	}
+	request := web.RetryPipelineRunRequest{SkipSucceeded: c.Bool("skip-succeeded")}
+	if v := c.String("vars"); v != "" {
+		if err = json.Unmarshal([]byte(v), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/runs/"+c.Args().Get(1)+"/retry", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	return s.renderAPIResponse(resp, &run, "Pipeline run successfully retried")
+}
+
+// SimulateJob executes the observationSource of a job spec in-memory, without
+// a database or live chains. The results of http, bridge and ethcall tasks are
+// substituted from the fixtures file, or recorded into it with --record. The
+// pipeline fragments included by the spec are fetched from the node.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	var spec struct {
+		Name              string `toml:"name"`
+		ObservationSource string `toml:"observationSource"`
+	}
+	if err = toml.Unmarshal([]byte(tomlString), &spec); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse job spec"))
+	}
+	if strings.TrimSpace(spec.ObservationSource) == "" {
+		return s.errorOut(errors.New("job spec has no observationSource"))
+	}
+
+	record := c.Bool("record")
+	fixturesPath := c.String("fixtures")
+	if record && fixturesPath == "" {
+		return s.errorOut(errors.New("--record requires --fixtures"))
+	}
+	fixtures := &pipeline.SimulationFixtures{}
+	if fixturesPath != "" {
+		b, rerr := os.ReadFile(fixturesPath)
+		switch {
+		case os.IsNotExist(rerr) && record:
+		case rerr != nil:
+			return s.errorOut(errors.Wrap(rerr, "failed to read fixtures"))
+		default:
+			if err = json.Unmarshal(b, fixtures); err != nil {
+				return s.errorOut(errors.Wrap(err, "failed to parse fixtures"))
+			}
+		}
+	}
+
+	vars := map[string]any{}
+	if v := c.String("vars"); v != "" {
+		if err = json.Unmarshal([]byte(v), &vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+
+	pipeline.SetFragmentResolver(s.fragmentResolver(s.ctx()))
+	sim := pipeline.NewSimulator(s.Config.JobPipeline(), fixtures, record, s.Logger, &http.Client{})
+	_, trrs, err := sim.Simulate(s.ctx(), pipeline.Spec{DotDagSource: spec.ObservationSource, JobName: spec.Name}, pipeline.NewVarsFrom(vars))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	results := pipeline.SimulatedTaskResults(trrs)
+
+	if record {
+		b, merr := json.MarshalIndent(sim.Fixtures(), "", "  ")
+		if merr != nil {
+			return s.errorOut(merr)
+		}
+		if err = os.WriteFile(fixturesPath, b, 0600); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to write fixtures"))
+		}
+	}
+
+	b, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Println(string(b))
+
+	if expectPath := c.String("expect"); expectPath != "" {
+		expectBytes, rerr := os.ReadFile(expectPath)
+		if rerr != nil {
+			return s.errorOut(errors.Wrap(rerr, "failed to read expected results"))
+		}
+		var expected []pipeline.SimulatedTaskResult
+		if err = json.Unmarshal(expectBytes, &expected); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse expected results"))
+		}
+		if diffs := diffSimulatedTaskResults(expected, results); len(diffs) > 0 {
+			return s.errorOut(fmt.Errorf("task results differ from %s:\n%s", expectPath, strings.Join(diffs, "\n")))
+		}
+	}
+	return nil
+}
+
+// diffSimulatedTaskResults compares task results by DOT ID using their JSON encoding.
+func diffSimulatedTaskResults(expected, actual []pipeline.SimulatedTaskResult) (diffs []string) {
+	encode := func(results []pipeline.SimulatedTaskResult) (map[string]string, []string) {
+		encoded := make(map[string]string, len(results))
+		var order []string
+		for _, r := range results {
+			b, _ := json.Marshal(r)
+			encoded[r.DotID] = string(b)
+			order = append(order, r.DotID)
+		}
+		return encoded, order
+	}
+	want, wantOrder := encode(expected)
+	got, gotOrder := encode(actual)
+
+	for _, id := range wantOrder {
+		g, ok := got[id]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("- %s: %s", id, want[id]))
+		} else if g != want[id] {
+			diffs = append(diffs, fmt.Sprintf("~ %s: expected %s, got %s", id, want[id], g))
+		}
+	}
+	for _, id := range gotOrder {
+		if _, ok := want[id]; !ok {
+			diffs = append(diffs, fmt.Sprintf("+ %s: %s", id, got[id]))
+		}
+	}
+	return diffs
+}
@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,6 +22,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -453,6 +457,96 @@ func TestShell_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestShell_JobHistory(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Database.Listener.FallbackPollInterval = commonconfig.MustNewDuration(100 * time.Millisecond)
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+	})
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.CreateJob, fs, "")
+	require.NoError(t, fs.Parse([]string{"--comment", "initial", getDirectRequestSpec()}))
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	jobID := r.Renders[0].(*cmd.JobPresenter).ID
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RollbackJob, set, "")
+	require.NoError(t, set.Parse([]string{jobID, "1"}))
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+	assert.Equal(t, jobID, r.Renders[1].(*cmd.JobPresenter).ID)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.JobHistory, set, "")
+	require.NoError(t, set.Parse([]string{jobID}))
+	require.NoError(t, client.JobHistory(cli.NewContext(nil, set, nil)))
+	revs := *r.Renders[2].(*cmd.JobSpecRevisionPresenters)
+	require.Len(t, revs, 2)
+	assert.Equal(t, "rollback to revision 1", revs[0].Comment)
+	assert.Equal(t, "initial", revs[1].Comment)
+	assert.Equal(t, revs[0].TOML, revs[1].TOML)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DiffJobRevisions, set, "")
+	require.NoError(t, set.Parse([]string{jobID, "1", "2"}))
+	require.NoError(t, client.DiffJobRevisions(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DiffJobRevisions, set, "")
+	require.NoError(t, set.Parse([]string{jobID, "1"}))
+	require.Error(t, client.DiffJobRevisions(cli.NewContext(nil, set, nil)))
+}
+
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	client := cmd.Shell{
+		Config: configtest.NewTestGeneralConfig(t),
+		Logger: logger.TestLogger(t),
+	}
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.toml")
+	require.NoError(t, os.WriteFile(specPath, []byte(`
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds1       [type=bridge name=price_adapter];
+ds1_parse [type=jsonparse path="data,result"];
+ds1 -> ds1_parse;
+"""
+`), 0600))
+	fixturesPath := filepath.Join(dir, "fixtures.json")
+	require.NoError(t, os.WriteFile(fixturesPath, []byte(`{"tasks":{"ds1":{"value":"{\"data\":{\"result\":42}}"}}}`), 0600))
+	expectPath := filepath.Join(dir, "expect.json")
+	require.NoError(t, os.WriteFile(expectPath, []byte(`[
+		{"dotID":"ds1","type":"bridge","value":"{\"data\":{\"result\":42}}","error":null},
+		{"dotID":"ds1_parse","type":"jsonparse","value":42,"error":null}
+	]`), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Set("fixtures", fixturesPath))
+	require.NoError(t, set.Set("expect", expectPath))
+	require.NoError(t, set.Parse([]string{specPath}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+
+	// a changed fixture produces a diff against the expected results
+	require.NoError(t, os.WriteFile(fixturesPath, []byte(`{"tasks":{"ds1":{"value":"{\"data\":{\"result\":43}}"}}}`), 0600))
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Set("fixtures", fixturesPath))
+	require.NoError(t, set.Set("expect", expectPath))
+	require.NoError(t, set.Parse([]string{specPath}))
+	err := client.SimulateJob(cli.NewContext(nil, set, nil))
+	require.ErrorContains(t, err, "ds1_parse")
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	ctx := testutils.Context(t)
 	jobs, _, err := orm.FindJobs(ctx, 0, 1000)
@@ -7,7 +7,9 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/kek"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -19,9 +21,14 @@ type TerminalKeyStoreAuthenticator struct {
 
 type KeystorePassword interface {
 	Keystore() string
+	KeystoreKEK() config.KeystoreKEK
 }
 
 func (auth TerminalKeyStoreAuthenticator) Authenticate(ctx context.Context, keyStore keystore.Master, password KeystorePassword) error {
+	if password.KeystoreKEK().Provider() != "" {
+		// the password is only needed to migrate a keystore encrypted with it, so its strength is irrelevant
+		return unlockWithKEK(ctx, keyStore, password)
+	}
 	isEmpty, err := keyStore.IsEmpty(ctx)
 	if err != nil {
 		return errors.Wrap(err, "error determining if keystore is empty")
@@ -51,6 +58,23 @@ func (auth TerminalKeyStoreAuthenticator) Authenticate(ctx context.Context, keyS
 	return keyStore.Unlock(ctx, pw)
 }
 
+// unlockKeyStore unlocks keyStore non-interactively, with the key encryption key provider if one is configured.
+func unlockKeyStore(ctx context.Context, keyStore keystore.Master, password KeystorePassword) error {
+	if password.KeystoreKEK().Provider() != "" {
+		return unlockWithKEK(ctx, keyStore, password)
+	}
+	return keyStore.Unlock(ctx, password.Keystore())
+}
+
+func unlockWithKEK(ctx context.Context, keyStore keystore.Master, password KeystorePassword) error {
+	cfg := password.KeystoreKEK()
+	provider, err := kek.New(cfg.Provider(), cfg.Path(), cfg.KeyID())
+	if err != nil {
+		return err
+	}
+	return keyStore.UnlockWithKEK(ctx, provider, password.Keystore())
+}
+
 func (auth TerminalKeyStoreAuthenticator) validatePasswordStrength(password string) error {
 	return utils.VerifyPasswordComplexity(password)
 }
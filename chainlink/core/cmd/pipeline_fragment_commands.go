@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initPipelineFragmentSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Create a pipeline fragment from a name and a DOT source filepath",
+			Action: s.CreatePipelineFragment,
+		},
+		{
+			Name:   "destroy",
+			Usage:  "Destroys a pipeline fragment which no job includes",
+			Action: s.RemovePipelineFragment,
+		},
+		{
+			Name:   "list",
+			Usage:  "List all pipeline fragments",
+			Action: s.IndexPipelineFragments,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "show",
+			Usage:  "Show a pipeline fragment's source",
+			Action: s.ShowPipelineFragment,
+		},
+	}
+}
+
+type PipelineFragmentPresenter struct {
+	presenters.PipelineFragmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineFragmentPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Source", "Updated At"})
+	table.Append([]string{
+		p.Name,
+		p.Source,
+		p.UpdatedAt.String(),
+	})
+	render("Pipeline Fragment", table)
+	return nil
+}
+
+type PipelineFragmentPresenters []PipelineFragmentPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineFragmentPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Updated At"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.Name,
+			p.UpdatedAt.String(),
+		})
+	}
+
+	render("Pipeline Fragments", table)
+	return nil
+}
+
+// IndexPipelineFragments returns all pipeline fragments.
+func (s *Shell) IndexPipelineFragments(c *cli.Context) (err error) {
+	return s.getPage("/v2/pipeline_fragments", c.Int("page"), &PipelineFragmentPresenters{})
+}
+
+// ShowPipelineFragment returns the pipeline fragment with the given name.
+func (s *Shell) ShowPipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline_fragments/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// CreatePipelineFragment adds a new pipeline fragment to the chainlink node
+func (s *Shell) CreatePipelineFragment(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment and the filepath of its DOT source"))
+	}
+	source, err := os.ReadFile(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	request, err := json.Marshal(web.PipelineFragmentRequest{
+		Name:   c.Args().First(),
+		Source: string(source),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline_fragments", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// RemovePipelineFragment removes a pipeline fragment by name.
+func (s *Shell) RemovePipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment to be removed"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/pipeline_fragments/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// fragmentResolver returns a pipeline.FragmentResolver which fetches the pipeline fragments from the node.
+func (s *Shell) fragmentResolver(ctx context.Context) pipeline.FragmentResolver {
+	return func(name string) (source string, err error) {
+		resp, err := s.HTTP.Get(ctx, "/v2/pipeline_fragments/"+url.PathEscape(name))
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			if cerr := resp.Body.Close(); cerr != nil {
+				err = errors.Join(err, cerr)
+			}
+		}()
+		b, err := parseResponse(resp)
+		if err != nil {
+			return "", err
+		}
+		var fragment presenters.PipelineFragmentResource
+		if err = web.ParseJSONAPIResponse(b, &fragment); err != nil {
+			return "", err
+		}
+		return fragment.Source, nil
+	}
+}
@@ -222,7 +222,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	unrestrictedClient := clhttp.NewUnrestrictedClient()
 
 	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger(), ds)
 	if err != nil {
 		return nil, err
 	}
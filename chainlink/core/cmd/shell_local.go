@@ -723,7 +723,7 @@ func (s *Shell) RebroadcastTransactions(c *cli.Context) (err error) {
 		return s.errorOut(fmt.Errorf("error validating configuration: %w", err))
 	}
 
-	err = keyStore.Unlock(ctx, s.Config.Password().Keystore())
+	err = unlockKeyStore(ctx, keyStore, s.Config.Password())
 	if err != nil {
 		return s.errorOut(errors.Wrap(err, "error authenticating keystore"))
 	}
@@ -11,4 +11,6 @@ type AuditLogger interface {
 	Environment() string
 	JsonWrapperKey() string
 	Headers() (models.ServiceHeaders, error)
+	LocalSinkEnabled() bool
+	LocalSinkHashKey() string
 }
@@ -7,6 +7,9 @@ import (
 )
 
 type JobPipeline interface {
+	CircuitBreakerEnabled() bool
+	CircuitBreakerFailureThreshold() uint32
+	CircuitBreakerResetTimeout() time.Duration
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
 	MaxRunDuration() time.Duration
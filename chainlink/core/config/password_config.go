@@ -3,4 +3,12 @@ package config
 type Password interface {
 	Keystore() string
 	VRF() string
+	KeystoreKEK() KeystoreKEK
+}
+
+// KeystoreKEK is the external key encryption key provider unlocking the keystore, if Provider is not empty.
+type KeystoreKEK interface {
+	Provider() string
+	Path() string
+	KeyID() string
 }
@@ -131,15 +131,16 @@ func (c *Core) ValidateConfig() (err error) {
 }
 
 type Secrets struct {
-	Database   DatabaseSecrets          `toml:",omitempty"`
-	Password   Passwords                `toml:",omitempty"`
-	WebServer  WebServerSecrets         `toml:",omitempty"`
-	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
-	Prometheus PrometheusSecrets        `toml:",omitempty"`
-	Mercury    MercurySecrets           `toml:",omitempty"`
-	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
-	EVM        EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
-	Solana     SolKeys                  `toml:",omitempty"` // choose Solana as the TOML field name to align with relayer config convention
+	Database    DatabaseSecrets          `toml:",omitempty"`
+	Password    Passwords                `toml:",omitempty"`
+	WebServer   WebServerSecrets         `toml:",omitempty"`
+	Pyroscope   PyroscopeSecrets         `toml:",omitempty"`
+	Prometheus  PrometheusSecrets        `toml:",omitempty"`
+	AuditLogger AuditLoggerSecrets       `toml:",omitempty"`
+	Mercury     MercurySecrets           `toml:",omitempty"`
+	Threshold   ThresholdKeyShareSecrets `toml:",omitempty"`
+	EVM         EthKeys                  `toml:",omitempty"` // choose EVM as the TOML field name to align with relayer config convention
+	Solana      SolKeys                  `toml:",omitempty"` // choose Solana as the TOML field name to align with relayer config convention
 
 	P2PKey          P2PKey          `toml:",omitempty"`
 	DKGRecipientKey DKGRecipientKey `toml:",omitempty"`
@@ -493,8 +494,9 @@ func (p *DKGRecipientKey) ValidateConfig() (err error) {
 }
 
 type Passwords struct {
-	Keystore *models.Secret
-	VRF      *models.Secret
+	Keystore    *models.Secret
+	VRF         *models.Secret
+	KeystoreKEK KeystoreKEK `toml:",omitempty"`
 }
 
 func (p *Passwords) SetFrom(f *Passwords) (err error) {
@@ -510,7 +512,7 @@ func (p *Passwords) SetFrom(f *Passwords) (err error) {
 		p.VRF = v
 	}
 
-	return nil
+	return p.KeystoreKEK.SetFrom(&f.KeystoreKEK)
 }
 
 func (p *Passwords) validateMerge(f *Passwords) (err error) {
@@ -526,12 +528,69 @@ func (p *Passwords) validateMerge(f *Passwords) (err error) {
 }
 
 func (p *Passwords) ValidateConfig() (err error) {
-	if p.Keystore == nil || *p.Keystore == "" {
+	// the keystore can be unlocked by the key encryption key provider instead
+	if (p.Keystore == nil || *p.Keystore == "") && p.KeystoreKEK.Provider == nil {
 		err = errors.Join(err, configutils.ErrEmpty{Name: "Keystore", Msg: "must be provided and non-empty"})
 	}
 	return err
 }
 
+// KeystoreKEK configures an external key encryption key provider wrapping the data key of the keystore, so that the
+// node can be unlocked without the keystore password.
+type KeystoreKEK struct {
+	Provider *string
+	Path     *string
+	KeyID    *string
+}
+
+func (k *KeystoreKEK) SetFrom(f *KeystoreKEK) (err error) {
+	err = k.validateMerge(f)
+	if err != nil {
+		return err
+	}
+	if v := f.Provider; v != nil {
+		k.Provider = v
+	}
+	if v := f.Path; v != nil {
+		k.Path = v
+	}
+	if v := f.KeyID; v != nil {
+		k.KeyID = v
+	}
+	return nil
+}
+
+func (k *KeystoreKEK) validateMerge(f *KeystoreKEK) (err error) {
+	if k.Provider != nil && f.Provider != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "Provider"})
+	}
+	if k.Path != nil && f.Path != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "Path"})
+	}
+	if k.KeyID != nil && f.KeyID != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "KeyID"})
+	}
+	return err
+}
+
+func (k *KeystoreKEK) ValidateConfig() (err error) {
+	if k.Provider == nil {
+		if k.Path != nil || k.KeyID != nil {
+			err = errors.Join(err, configutils.ErrMissing{Name: "Provider", Msg: "must be set when Path or KeyID is set"})
+		}
+		return err
+	}
+	switch *k.Provider {
+	case "file", "socket":
+	default:
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Provider", Value: *k.Provider, Msg: "must be one of: file, socket"})
+	}
+	if k.Path == nil || *k.Path == "" {
+		err = errors.Join(err, configutils.ErrEmpty{Name: "Path", Msg: "must be provided and non-empty"})
+	}
+	return err
+}
+
 type PyroscopeSecrets struct {
 	AuthToken *models.Secret
 }
@@ -557,6 +616,31 @@ func (p *PyroscopeSecrets) validateMerge(f *PyroscopeSecrets) (err error) {
 	return err
 }
 
+type AuditLoggerSecrets struct {
+	LocalSinkHashKey *models.Secret
+}
+
+func (a *AuditLoggerSecrets) SetFrom(f *AuditLoggerSecrets) (err error) {
+	err = a.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.LocalSinkHashKey; v != nil {
+		a.LocalSinkHashKey = v
+	}
+
+	return nil
+}
+
+func (a *AuditLoggerSecrets) validateMerge(f *AuditLoggerSecrets) (err error) {
+	if a.LocalSinkHashKey != nil && f.LocalSinkHashKey != nil {
+		err = errors.Join(err, configutils.ErrOverride{Name: "LocalSinkHashKey"})
+	}
+
+	return err
+}
+
 type PrometheusSecrets struct {
 	AuthToken *models.Secret
 }
@@ -779,10 +863,11 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 }
 
 type AuditLogger struct {
-	Enabled        *bool
-	ForwardToUrl   *commonconfig.URL
-	JsonWrapperKey *string
-	Headers        *[]models.ServiceHeader
+	Enabled          *bool
+	ForwardToUrl     *commonconfig.URL
+	JsonWrapperKey   *string
+	Headers          *[]models.ServiceHeader
+	LocalSinkEnabled *bool
 }
 
 func (p *AuditLogger) SetFrom(f *AuditLogger) {
@@ -798,6 +883,9 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	if v := f.Headers; v != nil {
 		p.Headers = v
 	}
+	if v := f.LocalSinkEnabled; v != nil {
+		p.LocalSinkEnabled = v
+	}
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -1277,7 +1365,8 @@ type JobPipeline struct {
 	ResultWriteQueueDepth     *uint32
 	VerboseLogging            *bool
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest    JobPipelineHTTPRequest    `toml:",omitempty"`
+	CircuitBreaker JobPipelineCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -1303,6 +1392,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.CircuitBreaker.setFrom(&f.CircuitBreaker)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -1319,6 +1409,24 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineCircuitBreaker struct {
+	Enabled          *bool
+	FailureThreshold *uint32
+	ResetTimeout     *commonconfig.Duration
+}
+
+func (j *JobPipelineCircuitBreaker) setFrom(f *JobPipelineCircuitBreaker) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.ResetTimeout; v != nil {
+		j.ResetTimeout = v
+	}
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
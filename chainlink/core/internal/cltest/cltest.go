@@ -198,7 +198,7 @@ func NewJobPipelineV2(t testing.TB, cfg pipeline.BridgeConfig, jpcfg JobPipeline
 	prm := pipeline.NewORM(db, lggr, jpcfg.MaxSuccessfulRuns())
 	btORM := bridges.NewORM(db)
 	jrm := job.NewORM(db, prm, btORM, keyStore, lggr)
-	pr := pipeline.NewRunner(prm, btORM, jpcfg, cfg, legacyChains, keyStore.Eth(), keyStore.VRF(), lggr, restrictedHTTPClient, unrestrictedHTTPClient)
+	pr := pipeline.NewRunner(prm, btORM, nil, jpcfg, cfg, legacyChains, keyStore.Eth(), keyStore.VRF(), lggr, restrictedHTTPClient, unrestrictedHTTPClient)
 	return JobPipelineV2TestHelper{
 		prm,
 		jrm,
@@ -763,6 +763,8 @@ func (ta *TestApplication) Import(ctx context.Context, content string) {
 type User struct {
 	Email string
 	Role  clsessions.UserRole
+	// CustomRole is the name of an existing custom role assigned to the user in place of Role.
+	CustomRole string
 }
 
 func (ta *TestApplication) NewHTTPClient(user *User) HTTPClientCleaner {
@@ -787,6 +789,11 @@ func (ta *TestApplication) NewHTTPClient(user *User) HTTPClientCleaner {
 	err = ta.BasicAdminUsersORM().CreateUser(ctx, &u)
 	require.NoError(ta.t, err)
 
+	if user.CustomRole != "" {
+		_, err = ta.AuthenticationProvider().UpdateRole(ctx, user.Email, user.CustomRole)
+		require.NoError(ta.t, err)
+	}
+
 	sessionID := ta.MustSeedNewSession(user.Email)
 
 	return HTTPClientCleaner{
@@ -61,6 +61,53 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// APITokensORM provides a mock function with no fields
+func (_m *Application) APITokensORM() sessions.APITokensORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokensORM")
+	}
+
+	var r0 sessions.APITokensORM
+	if rf, ok := ret.Get(0).(func() sessions.APITokensORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sessions.APITokensORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_APITokensORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APITokensORM'
+type Application_APITokensORM_Call struct {
+	*mock.Call
+}
+
+// APITokensORM is a helper method to define mock.On call
+func (_e *Application_Expecter) APITokensORM() *Application_APITokensORM_Call {
+	return &Application_APITokensORM_Call{Call: _e.mock.On("APITokensORM")}
+}
+
+func (_c *Application_APITokensORM_Call) Run(run func()) *Application_APITokensORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) Return(_a0 sessions.APITokensORM) *Application_APITokensORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_APITokensORM_Call) RunAndReturn(run func() sessions.APITokensORM) *Application_APITokensORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -202,6 +249,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealthChecker provides a mock function with no fields
+func (_m *Application) BridgeHealthChecker() *bridges.HealthChecker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealthChecker")
+	}
+
+	var r0 *bridges.HealthChecker
+	if rf, ok := ret.Get(0).(func() *bridges.HealthChecker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthChecker)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealthChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealthChecker'
+type Application_BridgeHealthChecker_Call struct {
+	*mock.Call
+}
+
+// BridgeHealthChecker is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealthChecker() *Application_BridgeHealthChecker_Call {
+	return &Application_BridgeHealthChecker_Call{Call: _e.mock.On("BridgeHealthChecker")}
+}
+
+func (_c *Application_BridgeHealthChecker_Call) Run(run func()) *Application_BridgeHealthChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealthChecker_Call) Return(_a0 *bridges.HealthChecker) *Application_BridgeHealthChecker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealthChecker_Call) RunAndReturn(run func() *bridges.HealthChecker) *Application_BridgeHealthChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1200,6 +1294,53 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReplaceJob provides a mock function with given fields: ctx, jb
+func (_m *Application) ReplaceJob(ctx context.Context, jb *job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ReplaceJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceJob'
+type Application_ReplaceJob_Call struct {
+	*mock.Call
+}
+
+// ReplaceJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+func (_e *Application_Expecter) ReplaceJob(ctx interface{}, jb interface{}) *Application_ReplaceJob_Call {
+	return &Application_ReplaceJob_Call{Call: _e.mock.On("ReplaceJob", ctx, jb)}
+}
+
+func (_c *Application_ReplaceJob_Call) Run(run func(ctx context.Context, jb *job.Job)) *Application_ReplaceJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Application_ReplaceJob_Call) Return(_a0 error) *Application_ReplaceJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ReplaceJob_Call) RunAndReturn(run func(context.Context, *job.Job) error) *Application_ReplaceJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: ctx, chainFamily, chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(ctx, chainFamily, chainID, number, forceBroadcast)
@@ -1250,6 +1391,75 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ReplayPipelineRun provides a mock function with given fields: ctx, bundle, recordedTasks
+func (_m *Application) ReplayPipelineRun(ctx context.Context, bundle pipeline.RunBundle, recordedTasks []string) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, bundle, recordedTasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayPipelineRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunBundle, []string) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, bundle, recordedTasks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunBundle, []string) *pipeline.Run); ok {
+		r0 = rf(ctx, bundle, recordedTasks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RunBundle, []string) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, bundle, recordedTasks)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.RunBundle, []string) error); ok {
+		r2 = rf(ctx, bundle, recordedTasks)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_ReplayPipelineRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayPipelineRun'
+type Application_ReplayPipelineRun_Call struct {
+	*mock.Call
+}
+
+// ReplayPipelineRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundle pipeline.RunBundle
+//   - recordedTasks []string
+func (_e *Application_Expecter) ReplayPipelineRun(ctx interface{}, bundle interface{}, recordedTasks interface{}) *Application_ReplayPipelineRun_Call {
+	return &Application_ReplayPipelineRun_Call{Call: _e.mock.On("ReplayPipelineRun", ctx, bundle, recordedTasks)}
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Run(run func(ctx context.Context, bundle pipeline.RunBundle, recordedTasks []string)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.RunBundle), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) RunAndReturn(run func(context.Context, pipeline.RunBundle, []string) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1298,6 +1508,111 @@ func (_c *Application_ResumeJobV2_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// RetryJobRunV2 provides a mock function with given fields: ctx, runID, opts
+func (_m *Application) RetryJobRunV2(ctx context.Context, runID int64, opts pipeline.RetryOptions) (int64, error) {
+	ret := _m.Called(ctx, runID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryJobRunV2")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, pipeline.RetryOptions) (int64, error)); ok {
+		return rf(ctx, runID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, pipeline.RetryOptions) int64); ok {
+		r0 = rf(ctx, runID, opts)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, pipeline.RetryOptions) error); ok {
+		r1 = rf(ctx, runID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_RetryJobRunV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryJobRunV2'
+type Application_RetryJobRunV2_Call struct {
+	*mock.Call
+}
+
+// RetryJobRunV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+//   - opts pipeline.RetryOptions
+func (_e *Application_Expecter) RetryJobRunV2(ctx interface{}, runID interface{}, opts interface{}) *Application_RetryJobRunV2_Call {
+	return &Application_RetryJobRunV2_Call{Call: _e.mock.On("RetryJobRunV2", ctx, runID, opts)}
+}
+
+func (_c *Application_RetryJobRunV2_Call) Run(run func(ctx context.Context, runID int64, opts pipeline.RetryOptions)) *Application_RetryJobRunV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(pipeline.RetryOptions))
+	})
+	return _c
+}
+
+func (_c *Application_RetryJobRunV2_Call) Return(_a0 int64, _a1 error) *Application_RetryJobRunV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_RetryJobRunV2_Call) RunAndReturn(run func(context.Context, int64, pipeline.RetryOptions) (int64, error)) *Application_RetryJobRunV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RolesORM provides a mock function with no fields
+func (_m *Application) RolesORM() sessions.RolesORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RolesORM")
+	}
+
+	var r0 sessions.RolesORM
+	if rf, ok := ret.Get(0).(func() sessions.RolesORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(sessions.RolesORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_RolesORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RolesORM'
+type Application_RolesORM_Call struct {
+	*mock.Call
+}
+
+// RolesORM is a helper method to define mock.On call
+func (_e *Application_Expecter) RolesORM() *Application_RolesORM_Call {
+	return &Application_RolesORM_Call{Call: _e.mock.On("RolesORM")}
+}
+
+func (_c *Application_RolesORM_Call) Run(run func()) *Application_RolesORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_RolesORM_Call) Return(_a0 sessions.RolesORM) *Application_RolesORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_RolesORM_Call) RunAndReturn(run func() sessions.RolesORM) *Application_RolesORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -11,10 +11,12 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -22,6 +24,8 @@ import (
 
 const bufferCapacity = 2048
 const webRequestTimeout = 10
+const localSinkTimeout = 5 * time.Second
+const localSinkChainInterval = time.Second
 
 type Data = map[string]any
 
@@ -45,10 +49,15 @@ type AuditLoggerService struct {
 	hostname        string                   // The self-reported hostname of the machine
 	localIP         string                   // A non-loopback IP address as reported by the machine
 	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
+	localSink       ORM                      // Persists hash-chained logs to the database, if enabled
 
 	loggingChannel chan wrappedAuditLog
 	chStop         services.StopChan
-	chDone         chan struct{}
+	wg             sync.WaitGroup
+
+	localSinkMu         sync.Mutex
+	localSinkEnqueueErr error // The last failure to enqueue a log to the local sink, reported as unhealthy
+	localSinkChainErr   error // The last failure to chain the logs of the local sink, reported as unhealthy
 }
 
 type wrappedAuditLog struct {
@@ -59,11 +68,12 @@ type wrappedAuditLog struct {
 var NoopLogger AuditLogger = &AuditLoggerService{}
 
 // NewAuditLogger returns a buffer push system that ingests audit log events and
-// asynchronously pushes them up to an HTTP log service.
+// asynchronously pushes them up to an HTTP log service, and persists them to ds
+// if the local sink is enabled.
 // Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
 // AuditLogger instance. If the environment variables are not set, the logger
 // is disabled and short circuits execution via enabled flag.
-func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
+func NewAuditLogger(logger logger.Logger, config config.AuditLogger, ds sqlutil.DataSource) (AuditLogger, error) {
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
 	if config == nil || !config.Enabled() {
@@ -85,6 +95,17 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		return &AuditLoggerService{}, nil
 	}
 
+	var localSink ORM
+	if config.LocalSinkEnabled() {
+		if ds == nil {
+			return nil, errors.New("initialization error - the local sink requires a database")
+		}
+		if config.LocalSinkHashKey() == "" {
+			return nil, fmt.Errorf("initialization error - %w", errNoHashKey)
+		}
+		localSink = NewORM(ds, config.LocalSinkHashKey())
+	}
+
 	loggingChannel := make(chan wrappedAuditLog, bufferCapacity)
 
 	// Create new AuditLoggerService
@@ -98,10 +119,10 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		hostname:        hostname,
 		localIP:         getLocalIP(),
 		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		localSink:       localSink,
 
 		loggingChannel: loggingChannel,
 		chStop:         make(chan struct{}),
-		chDone:         make(chan struct{}),
 	}
 
 	return &auditLogger, nil
@@ -111,16 +132,29 @@ func (l *AuditLoggerService) SetLoggingClient(newClient HTTPAuditLoggerInterface
 	l.loggingClient = newClient
 }
 
-// Entrypoint for new audit logs. This buffers all logs that come in they will
-// sent out by the goroutine that was started when the AuditLoggerService was
-// created. If this service was not enabled, this immeidately returns.
+func (l *AuditLoggerService) SetLocalSink(newSink ORM) {
+	l.localSink = newSink
+}
+
+// Entrypoint for new audit logs. Logs are enqueued to the local sink, if
+// enabled, before returning, so that they are never dropped from the hash chain,
+// and chained by the goroutine started with the AuditLoggerService. Logs sent to
+// the HTTP log service are buffered and sent out by the goroutine that was
+// started when the AuditLoggerService was created. If this service was not
+// enabled, this immeidately returns.
 //
-// This function never blocks.
+// This function only blocks on enqueuing to the local sink, which does not wait
+// for the chain lock.
 func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 	if !l.enabled {
 		return
 	}
 
+	l.persistLog(eventID, data)
+	if (*url.URL)(&l.forwardToUrl).String() == "" {
+		return
+	}
+
 	wrappedLog := wrappedAuditLog{
 		eventID: eventID,
 		data:    data,
@@ -139,7 +173,12 @@ func (l *AuditLoggerService) Start(context.Context) error {
 		return errors.New("The audit logger is not enabled")
 	}
 
+	l.wg.Add(1)
 	go l.runLoop()
+	if l.localSink != nil {
+		l.wg.Add(1)
+		go l.runChainLoop()
+	}
 	return nil
 }
 
@@ -151,7 +190,7 @@ func (l *AuditLoggerService) Close() error {
 
 	l.logger.Warnf("Disabled the audit logger service")
 	close(l.chStop)
-	<-l.chDone
+	l.wg.Wait()
 
 	return nil
 }
@@ -166,6 +205,8 @@ func (l *AuditLoggerService) HealthReport() map[string]error {
 		err = errors.New("the audit logger is not enabled")
 	} else if len(l.loggingChannel) == bufferCapacity {
 		err = errors.New("buffer is full")
+	} else if sinkErr := l.getLocalSinkErr(); sinkErr != nil {
+		err = fmt.Errorf("local sink: %w", sinkErr)
 	}
 	return map[string]error{l.Name(): err}
 }
@@ -183,7 +224,7 @@ func (l *AuditLoggerService) Ready() error {
 //
 // This function calls postLogToLogService which blocks.
 func (l *AuditLoggerService) runLoop() {
-	defer close(l.chDone)
+	defer l.wg.Done()
 
 	for {
 		select {
@@ -196,6 +237,70 @@ func (l *AuditLoggerService) runLoop() {
 	}
 }
 
+// runChainLoop periodically appends the logs enqueued to the local sink to its
+// hash chain, and once more when shutting down.
+func (l *AuditLoggerService) runChainLoop() {
+	defer l.wg.Done()
+
+	ticker := services.NewTicker(localSinkChainInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.chStop:
+			l.chainLogs()
+			return
+		case <-ticker.C:
+			l.chainLogs()
+		}
+	}
+}
+
+// chainLogs appends all the logs enqueued to the local sink to its hash chain.
+// Logs which fail to be chained stay queued and are retried on the next tick.
+func (l *AuditLoggerService) chainLogs() {
+	ctx, cancel := context.WithTimeout(context.Background(), localSinkTimeout)
+	defer cancel()
+	for {
+		n, err := l.localSink.Chain(ctx)
+		l.setLocalSinkErr(&l.localSinkChainErr, err)
+		if err != nil {
+			l.logger.Errorw("failed to chain audit logs of the local sink, they stay queued", "err", err)
+			return
+		}
+		if n < chainBatchSize {
+			return
+		}
+	}
+}
+
+// persistLog enqueues the log to the local sink, if enabled. A log which cannot
+// be persisted is lost, so the failure is logged as critical and reported by the
+// health check until a log is persisted again.
+func (l *AuditLoggerService) persistLog(eventID EventID, data Data) {
+	if l.localSink == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), localSinkTimeout)
+	defer cancel()
+	err := l.localSink.Enqueue(ctx, eventID, data)
+	if err != nil {
+		l.logger.Criticalw("failed to persist audit log to the local sink, the log is lost", "err", err, "eventID", eventID, "data", data)
+	}
+	l.setLocalSinkErr(&l.localSinkEnqueueErr, err)
+}
+
+func (l *AuditLoggerService) setLocalSinkErr(dst *error, err error) {
+	l.localSinkMu.Lock()
+	defer l.localSinkMu.Unlock()
+	*dst = err
+}
+
+func (l *AuditLoggerService) getLocalSinkErr() error {
+	l.localSinkMu.Lock()
+	defer l.localSinkMu.Unlock()
+	return errors.Join(l.localSinkEnqueueErr, l.localSinkChainErr)
+}
+
 // Takes an EventID and associated data and sends it to the configured logging
 // endpoint. This function blocks on the send by timesout after a period of
 // several seconds. This helps us prevent getting stuck on a single log
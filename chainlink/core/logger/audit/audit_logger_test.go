@@ -1,10 +1,13 @@
 package audit_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"io"
 	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -82,6 +85,14 @@ func (c Config) JsonWrapperKey() string {
 	return ""
 }
 
+func (c Config) LocalSinkEnabled() bool {
+	return false
+}
+
+func (c Config) LocalSinkHashKey() string {
+	return ""
+}
+
 func TestCheckLoginAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -100,7 +111,7 @@ func TestCheckLoginAuditLog(t *testing.T) {
 	auditLoggerTestConfig := Config{}
 
 	// Create new AuditLoggerService
-	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig)
+	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig, nil)
 	assert.NoError(t, err)
 
 	// Cast to concrete type so we can swap out the internals
@@ -144,3 +155,91 @@ func TestCheckLoginAuditLog(t *testing.T) {
 
 	assert.True(t, false)
 }
+
+type recordingSink struct {
+	audit.ORM
+
+	mu         sync.Mutex
+	enqueueErr error
+	queued     []audit.EventID
+	chained    []audit.EventID
+}
+
+func (s *recordingSink) Enqueue(_ context.Context, eventID audit.EventID, _ audit.Data) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.enqueueErr != nil {
+		return s.enqueueErr
+	}
+	s.queued = append(s.queued, eventID)
+	return nil
+}
+
+func (s *recordingSink) Chain(context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := len(s.queued)
+	s.chained = append(s.chained, s.queued...)
+	s.queued = nil
+	return n, nil
+}
+
+func (s *recordingSink) counts() (queued, chained int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queued), len(s.chained)
+}
+
+func TestAuditLogger_LocalSink(t *testing.T) {
+	t.Parallel()
+
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), &Config{}, nil)
+	require.NoError(t, err)
+	auditLoggerService, ok := auditLogger.(*audit.AuditLoggerService)
+	require.True(t, ok)
+	sink := &recordingSink{}
+	auditLoggerService.SetLocalSink(sink)
+
+	// the logger is not started, so the buffer of the HTTP log service fills up and drops logs, but
+	// the local sink is written before Audit returns
+	const count = 3000
+	for range count {
+		auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": cltest.APIEmailAdmin})
+	}
+	queued, chained := sink.counts()
+	assert.Equal(t, count, queued)
+	assert.Zero(t, chained)
+
+	// the queued logs are chained in the background once started, and the rest on close
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	require.Eventually(t, func() bool {
+		_, chained := sink.counts()
+		return chained == count
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": cltest.APIEmailAdmin})
+	require.NoError(t, auditLogger.Close())
+	queued, chained = sink.counts()
+	assert.Zero(t, queued)
+	assert.Equal(t, count+1, chained)
+}
+
+func TestAuditLogger_LocalSinkFailure(t *testing.T) {
+	t.Parallel()
+
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), &Config{}, nil)
+	require.NoError(t, err)
+	auditLoggerService, ok := auditLogger.(*audit.AuditLoggerService)
+	require.True(t, ok)
+	sink := &recordingSink{enqueueErr: errors.New("connection refused")}
+	auditLoggerService.SetLocalSink(sink)
+
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": cltest.APIEmailAdmin})
+	err = auditLogger.HealthReport()[auditLogger.Name()]
+	require.ErrorContains(t, err, "connection refused")
+
+	sink.mu.Lock()
+	sink.enqueueErr = nil
+	sink.mu.Unlock()
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": cltest.APIEmailAdmin})
+	assert.NoError(t, auditLogger.HealthReport()[auditLogger.Name()])
+}
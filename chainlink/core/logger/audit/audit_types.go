@@ -20,6 +20,10 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	RoleCreated EventID = "ROLE_CREATED"
+	RoleUpdated EventID = "ROLE_UPDATED"
+	RoleDeleted EventID = "ROLE_DELETED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -51,12 +55,17 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordRotateAttemptFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATE_ATTEMPT_FAILED_MISMATCH"
+	KeystorePasswordRotated                     EventID = "KEYSTORE_PASSWORD_ROTATED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
 	JobCreated EventID = "JOB_CREATED"
 	JobDeleted EventID = "JOB_DELETED"
+	JobPaused  EventID = "JOB_PAUSED"
+	JobResumed EventID = "JOB_RESUMED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -69,6 +78,9 @@ const (
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
 
+	PipelineFragmentCreated EventID = "PIPELINE_FRAGMENT_CREATED"
+	PipelineFragmentDeleted EventID = "PIPELINE_FRAGMENT_DELETED"
+
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
@@ -87,8 +99,11 @@ const (
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
+	JobRunReplayed    EventID = "JOB_RUN_REPLAYED"
+	JobRunRetried     EventID = "JOB_RUN_RETRIED"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
-	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
+	UnauthedRunResumed      EventID = "UNAUTHED_RUN_RESUMED"
+	SignedWebhookRunCreated EventID = "SIGNED_WEBHOOK_RUN_CREATED"
 )
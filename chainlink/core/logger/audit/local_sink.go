@@ -0,0 +1,240 @@
+package audit
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	verifyBatchSize = 1000
+	chainBatchSize  = 1000
+)
+
+var errNoHashKey = errors.New("the local sink requires AuditLogger.LocalSinkHashKey to be set in the secrets")
+
+// Event is an audit event persisted by the local sink. Each event is hash-chained to the previous one, so that
+// modifying, inserting or removing an event anywhere but at the tail of the chain is detected by Verify. The hashes
+// are keyed with a secret which is not stored in the database, so that they cannot be recomputed by someone who can
+// only write to the database.
+type Event struct {
+	ID        int64
+	EventID   EventID
+	UserEmail string
+	Data      string
+	CreatedAt time.Time
+	PrevHash  string
+	Hash      string
+}
+
+// computeHash returns the HMAC of the event keyed with key, covering every field but its ID which is assigned by the
+// database.
+func (e Event) computeHash(key []byte) string {
+	h := hmac.New(sha256.New, key)
+	fmt.Fprintf(h, "%s\n%s\n%s\n%s\n%s", e.PrevHash, e.EventID, e.UserEmail, e.CreatedAt.UTC().Format(time.RFC3339Nano), e.Data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EventsFilter selects the persisted audit events. Zero values match every event.
+type EventsFilter struct {
+	EventID   EventID
+	UserEmail string
+	From      time.Time
+	To        time.Time
+	Limit     int
+	Offset    int
+}
+
+// VerifyResult is the outcome of checking the integrity of the hash chain.
+type VerifyResult struct {
+	// Events is the number of events checked.
+	Events int64
+	// LastHash is the hash of the last valid event, which can be recorded elsewhere to also detect the removal of
+	// events at the tail of the chain.
+	LastHash string
+	// FirstInvalidID is the ID of the first event which breaks the chain, 0 if the chain is valid.
+	FirstInvalidID int64
+	Reason         string
+}
+
+// Valid returns true if no event breaks the chain.
+func (r VerifyResult) Valid() bool {
+	return r.FirstInvalidID == 0
+}
+
+// ORM persists and queries the audit events of the local sink. Events are first enqueued, which is cheap, then
+// appended to the chain in the background by Chain.
+type ORM interface {
+	Enqueue(ctx context.Context, eventID EventID, data Data) error
+	Chain(ctx context.Context) (int, error)
+	FindEvents(ctx context.Context, filter EventsFilter) ([]Event, int, error)
+	Verify(ctx context.Context) (VerifyResult, error)
+}
+
+type orm struct {
+	ds      sqlutil.DataSource
+	hashKey []byte
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM returns the ORM of the audit events persisted by the local sink, chained with HMACs keyed with hashKey.
+func NewORM(ds sqlutil.DataSource, hashKey string) ORM {
+	return &orm{ds: ds, hashKey: []byte(hashKey)}
+}
+
+// Enqueue persists an event to the queue of the events to chain. It does not wait for the chain lock, so that
+// auditing does not block on concurrent appends.
+func (o *orm) Enqueue(ctx context.Context, eventID EventID, data Data) error {
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit event data: %w", err)
+	}
+	// Postgres only stores microseconds, which must match the hashed timestamp
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	stmt := `INSERT INTO audit_event_queue (event_id, user_email, data, created_at) VALUES ($1, $2, $3, $4)`
+	if _, err = o.ds.ExecContext(ctx, stmt, eventID, userEmail(data), string(serialized), createdAt); err != nil {
+		return fmt.Errorf("failed to enqueue audit event: %w", err)
+	}
+	return nil
+}
+
+// Chain moves a batch of queued events, in the order they were enqueued, to the tail of the chain and returns how
+// many were moved. Appends are serialized with a table lock, so that nodes sharing the database still build a single
+// chain.
+func (o *orm) Chain(ctx context.Context) (n int, err error) {
+	if len(o.hashKey) == 0 {
+		return 0, errNoHashKey
+	}
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, "LOCK TABLE audit_events IN EXCLUSIVE MODE"); err != nil {
+			return fmt.Errorf("failed to lock audit events: %w", err)
+		}
+		var queued []Event
+		stmt := `DELETE FROM audit_event_queue WHERE id IN (SELECT id FROM audit_event_queue ORDER BY id ASC LIMIT $1)
+RETURNING id, event_id, user_email, data, created_at`
+		if err := tx.SelectContext(ctx, &queued, stmt, chainBatchSize); err != nil {
+			return fmt.Errorf("failed to dequeue audit events: %w", err)
+		}
+		if len(queued) == 0 {
+			return nil
+		}
+		slices.SortFunc(queued, func(a, b Event) int { return cmp.Compare(a.ID, b.ID) })
+
+		var prevHashes []string
+		if err := tx.SelectContext(ctx, &prevHashes, "SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1"); err != nil {
+			return fmt.Errorf("failed to load previous audit event: %w", err)
+		}
+		var prevHash string
+		if len(prevHashes) > 0 {
+			prevHash = prevHashes[0]
+		}
+		for _, event := range queued {
+			event.CreatedAt = event.CreatedAt.UTC()
+			event.PrevHash = prevHash
+			event.Hash = event.computeHash(o.hashKey)
+			stmt := `INSERT INTO audit_events (event_id, user_email, data, created_at, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6)`
+			if _, err := tx.ExecContext(ctx, stmt, event.EventID, event.UserEmail, event.Data, event.CreatedAt, event.PrevHash, event.Hash); err != nil {
+				return fmt.Errorf("failed to append audit event: %w", err)
+			}
+			prevHash = event.Hash
+		}
+		n = len(queued)
+		return nil
+	})
+	return n, err
+}
+
+// FindEvents returns the events matching the filter, most recent first, along with their total count.
+func (o *orm) FindEvents(ctx context.Context, filter EventsFilter) (events []Event, count int, err error) {
+	var conds []string
+	var args []any
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.EventID != "" {
+		add("event_id = $%d", filter.EventID)
+	}
+	if filter.UserEmail != "" {
+		add("lower(user_email) = lower($%d)", filter.UserEmail)
+	}
+	if !filter.From.IsZero() {
+		add("created_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("created_at < $%d", filter.To)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	if err = o.ds.GetContext(ctx, &count, "SELECT count(*) FROM audit_events"+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+	stmt := "SELECT * FROM audit_events" + where + " ORDER BY id DESC"
+	if filter.Limit > 0 {
+		stmt += fmt.Sprintf(" LIMIT %d OFFSET %d", filter.Limit, filter.Offset)
+	}
+	if err = o.ds.SelectContext(ctx, &events, stmt, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to find audit events: %w", err)
+	}
+	return events, count, nil
+}
+
+// Verify walks the whole chain in order, checking that each event links to the previous one and that its hash
+// matches its content.
+func (o *orm) Verify(ctx context.Context) (result VerifyResult, err error) {
+	if len(o.hashKey) == 0 {
+		return result, errNoHashKey
+	}
+	var lastID int64
+	for {
+		var events []Event
+		stmt := "SELECT * FROM audit_events WHERE id > $1 ORDER BY id ASC LIMIT $2"
+		if err = o.ds.SelectContext(ctx, &events, stmt, lastID, verifyBatchSize); err != nil {
+			return result, fmt.Errorf("failed to load audit events: %w", err)
+		}
+		for _, event := range events {
+			switch {
+			case event.PrevHash != result.LastHash:
+				result.FirstInvalidID = event.ID
+				result.Reason = "previous hash does not match the hash of the previous event, events were removed or inserted"
+			case event.Hash != event.computeHash(o.hashKey):
+				result.FirstInvalidID = event.ID
+				result.Reason = "hash does not match the content of the event, the event was modified or the hash key changed"
+			}
+			if !result.Valid() {
+				return result, nil
+			}
+			result.Events++
+			result.LastHash = event.Hash
+			lastID = event.ID
+		}
+		if len(events) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// userEmail returns the user the event was triggered by, if its data records one.
+func userEmail(data Data) string {
+	for _, key := range []string{"user", "email"} {
+		if v, ok := data[key].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
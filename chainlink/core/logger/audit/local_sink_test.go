@@ -0,0 +1,152 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+const testHashKey = "audit-hash-key"
+
+// appendEvent enqueues an event, chains it and returns it as persisted.
+func appendEvent(t *testing.T, orm audit.ORM, eventID audit.EventID, data audit.Data) audit.Event {
+	ctx := testutils.Context(t)
+	require.NoError(t, orm.Enqueue(ctx, eventID, data))
+	n, err := orm.Chain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	events, _, err := orm.FindEvents(ctx, audit.EventsFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	return events[0]
+}
+
+func TestORM_AppendAndFindEvents(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db, testHashKey)
+
+	first := appendEvent(t, orm, audit.AuthLoginSuccessNo2FA, audit.Data{"email": "a@chainlink.test"})
+	assert.Empty(t, first.PrevHash)
+	second := appendEvent(t, orm, audit.APITokenCreated, audit.Data{"user": "b@chainlink.test", "name": "ci"})
+	assert.Equal(t, first.Hash, second.PrevHash)
+	appendEvent(t, orm, audit.APITokenCreated, audit.Data{"user": "a@chainlink.test", "name": "ci"})
+
+	events, count, err := orm.FindEvents(ctx, audit.EventsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, events, 3)
+	assert.Equal(t, second.ID, events[1].ID)
+	assert.JSONEq(t, `{"user": "b@chainlink.test", "name": "ci"}`, events[1].Data)
+
+	events, count, err = orm.FindEvents(ctx, audit.EventsFilter{EventID: audit.APITokenCreated, UserEmail: "A@chainlink.test"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, events, 1)
+	assert.Equal(t, "a@chainlink.test", events[0].UserEmail)
+
+	events, count, err = orm.FindEvents(ctx, audit.EventsFilter{Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, events, 1)
+	assert.Equal(t, second.ID, events[0].ID)
+
+	_, count, err = orm.FindEvents(ctx, audit.EventsFilter{From: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	_, count, err = orm.FindEvents(ctx, audit.EventsFilter{To: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestORM_Chain(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db, testHashKey)
+
+	for _, email := range []string{"a@chainlink.test", "b@chainlink.test", "c@chainlink.test"} {
+		require.NoError(t, orm.Enqueue(ctx, audit.AuthLoginSuccessNo2FA, audit.Data{"email": email}))
+	}
+	// queued events are not part of the chain yet
+	_, count, err := orm.FindEvents(ctx, audit.EventsFilter{})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	n, err := orm.Chain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = orm.Chain(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// events are chained in the order they were enqueued
+	events, _, err := orm.FindEvents(ctx, audit.EventsFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "c@chainlink.test", events[0].UserEmail)
+	assert.Equal(t, "a@chainlink.test", events[2].UserEmail)
+
+	_, err = audit.NewORM(db, "").Chain(ctx)
+	require.ErrorContains(t, err, "LocalSinkHashKey")
+}
+
+func TestORM_Verify(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db, testHashKey)
+
+	result, err := orm.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Zero(t, result.Events)
+
+	var events []audit.Event
+	for range 5 {
+		events = append(events, appendEvent(t, orm, audit.AuthLoginSuccessNo2FA, audit.Data{"email": "a@chainlink.test"}))
+	}
+
+	result, err = orm.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Equal(t, int64(5), result.Events)
+	assert.Equal(t, events[4].Hash, result.LastHash)
+
+	t.Run("other hash key", func(t *testing.T) {
+		result, err := audit.NewORM(db, "other-key").Verify(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Valid())
+		assert.Equal(t, events[0].ID, result.FirstInvalidID)
+	})
+
+	t.Run("modified event", func(t *testing.T) {
+		_, err := db.Exec(`UPDATE audit_events SET data = '{"email":"b@chainlink.test"}' WHERE id = $1`, events[3].ID)
+		require.NoError(t, err)
+
+		result, err := orm.Verify(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Valid())
+		assert.Equal(t, events[3].ID, result.FirstInvalidID)
+		assert.Equal(t, int64(3), result.Events)
+		assert.Contains(t, result.Reason, "modified")
+	})
+
+	t.Run("removed event", func(t *testing.T) {
+		_, err := db.Exec(`DELETE FROM audit_events WHERE id = $1`, events[1].ID)
+		require.NoError(t, err)
+
+		result, err := orm.Verify(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Valid())
+		assert.Equal(t, events[2].ID, result.FirstInvalidID)
+		assert.Contains(t, result.Reason, "removed")
+	})
+}
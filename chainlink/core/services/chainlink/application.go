@@ -77,6 +77,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
+	"github.com/smartcontractkit/chainlink/v2/core/services/logtrigger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -144,13 +145,21 @@ type Application interface {
 	JobORM() job.ORM
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeHealthChecker() *bridges.HealthChecker
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
+	RolesORM() sessions.RolesORM
+	APITokensORM() sessions.APITokensORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	ReplaceJob(ctx context.Context, job *job.Job) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// ReplayPipelineRun executes an exported run in-memory against the current spec of its job.
+	ReplayPipelineRun(ctx context.Context, bundle pipeline.RunBundle, recordedTasks []string) (*pipeline.Run, pipeline.TaskRunResults, error)
+	// RetryJobRunV2 starts a new run of the job of a run which finished with errors, from the vars of that run, and returns its ID.
+	RetryJobRunV2(ctx context.Context, runID int64, opts pipeline.RetryOptions) (int64, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]any) (int64, error)
 
@@ -182,7 +191,10 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeHealth             *bridges.HealthChecker
 	localAdminUsersORM       sessions.BasicAdminUsersORM
+	rolesORM                 sessions.RolesORM
+	apiTokensORM             sessions.APITokensORM
 	authenticationProvider   sessions.AuthenticationProvider // Note: this will be OIDC instance
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
@@ -526,14 +538,17 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	var (
 		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
 		bridgeORM      = bridges.NewORM(opts.DS)
+		bridgeHealth   = bridges.NewHealthChecker(bridgeORM, unrestrictedHTTPClient, globalLogger)
 		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, bridgeHealth, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
 		workflowORM    = workflowstore.NewInMemoryStore(globalLogger, clockwork.NewRealClock())
 	)
-	srvcs = append(srvcs, workflowORM)
+	srvcs = append(srvcs, workflowORM, bridgeHealth)
+	// fragments included by any pipeline parsed by the node are looked up in its database
+	pipeline.SetFragmentResolver(pipeline.NewFragmentResolver(context.Background(), pipelineORM))
 
 	promReporter := headreporter.NewLegacyEVMPrometheusReporter(opts.DS, legacyEVMChains)
 	evmChainIDs := make([]*big.Int, len(cfg.EVMConfigs()))
@@ -588,6 +603,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				jobORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -614,6 +630,14 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				pipelineRunner,
 				cfg.JobPipeline(),
 			),
+
+			job.LogTrigger: logtrigger.NewDelegate(
+				cfg,
+				opts.DS,
+				pipelineRunner,
+				legacyEVMChains,
+				globalLogger,
+			),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
@@ -748,6 +772,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	bridgeStatusReporter := bridgestatus.NewBridgeStatusReporter(
 		cfg.BridgeStatusReporter(),
 		bridgeORM,
+		bridgeHealth,
 		jobORM,
 		unrestrictedHTTPClient,
 		beholder.GetEmitter(),
@@ -815,7 +840,10 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeHealth:             bridgeHealth,
 		localAdminUsersORM:       localAdminUsersORM,
+		rolesORM:                 localauth.NewRolesORM(opts.DS),
+		apiTokensORM:             localauth.NewAPITokensORM(opts.DS),
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
@@ -1468,10 +1496,24 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealthChecker() *bridges.HealthChecker {
+	return app.bridgeHealth
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
 
+// RolesORM manages the custom roles of the local users.
+func (app *ChainlinkApplication) RolesORM() sessions.RolesORM {
+	return app.rolesORM
+}
+
+// APITokensORM manages the named API tokens of the local users.
+func (app *ChainlinkApplication) APITokensORM() sessions.APITokensORM {
+	return app.apiTokensORM
+}
+
 func (app *ChainlinkApplication) AuthenticationProvider() sessions.AuthenticationProvider {
 	return app.authenticationProvider
 }
@@ -1519,6 +1561,20 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+func (app *ChainlinkApplication) ReplaceJob(ctx context.Context, j *job.Job) error {
+	// Do not allow the job to be replaced if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(j.ID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.ReplaceJob(ctx, j)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -1597,6 +1653,40 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+func (app *ChainlinkApplication) ReplayPipelineRun(ctx context.Context, bundle pipeline.RunBundle, recordedTasks []string) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	jb, err := app.jobORM.FindJobByExternalJobID(ctx, bundle.ExternalJobID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "external job ID %v", bundle.ExternalJobID)
+	}
+	if jb.PipelineSpec == nil {
+		return nil, nil, errors.Errorf("job %v has no pipeline spec to replay", jb.ID)
+	}
+	return app.pipelineRunner.ReplayRun(ctx, *jb.PipelineSpec, bundle, recordedTasks)
+}
+
+func (app *ChainlinkApplication) RetryJobRunV2(ctx context.Context, runID int64, opts pipeline.RetryOptions) (int64, error) {
+	original, err := app.pipelineORM.FindRun(ctx, runID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "run ID %v", runID)
+	}
+	jb, err := app.jobORM.FindJob(ctx, original.PipelineSpec.JobID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "job ID %v", original.PipelineSpec.JobID)
+	}
+	if jb.PipelineSpec == nil {
+		return 0, errors.Errorf("job %v has no pipeline spec to retry", jb.ID)
+	}
+
+	run, err := pipeline.NewRetryRun(*jb.PipelineSpec, original, opts)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = app.pipelineRunner.Run(ctx, run, true, nil); err != nil {
+		return 0, err
+	}
+	return run.ID, nil
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -397,6 +397,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "Prometheus"))
 	}
 
+	if err2 := s.AuditLogger.SetFrom(&f.AuditLogger); err2 != nil {
+		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "AuditLogger"))
+	}
+
 	if err2 := s.Mercury.SetFrom(&f.Mercury); err2 != nil {
 		err = errors.Join(err, commonconfig.NamedMultiErrorList(err2, "Mercury"))
 	}
@@ -9,6 +9,7 @@ import (
 
 type auditLoggerConfig struct {
 	c toml.AuditLogger
+	s toml.AuditLoggerSecrets
 }
 
 func (a auditLoggerConfig) Enabled() bool {
@@ -33,3 +34,14 @@ func (a auditLoggerConfig) JsonWrapperKey() string {
 func (a auditLoggerConfig) Headers() (models.ServiceHeaders, error) {
 	return *a.c.Headers, nil
 }
+
+func (a auditLoggerConfig) LocalSinkEnabled() bool {
+	return *a.c.LocalSinkEnabled
+}
+
+func (a auditLoggerConfig) LocalSinkHashKey() string {
+	if a.s.LocalSinkHashKey == nil {
+		return ""
+	}
+	return string(*a.s.LocalSinkHashKey)
+}
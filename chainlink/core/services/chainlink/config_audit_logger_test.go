@@ -8,7 +8,8 @@ import (
 
 func TestAuditLoggerConfig(t *testing.T) {
 	opts := GeneralConfigOpts{
-		ConfigStrings: []string{fullTOML},
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
 	}
 	cfg, err := opts.New()
 	require.NoError(t, err)
@@ -17,6 +18,8 @@ func TestAuditLoggerConfig(t *testing.T) {
 
 	require.True(t, auditConfig.Enabled())
 	require.Equal(t, "event", auditConfig.JsonWrapperKey())
+	require.True(t, auditConfig.LocalSinkEnabled())
+	require.Equal(t, "audit-hash-key", auditConfig.LocalSinkHashKey())
 
 	fUrl, err := auditConfig.ForwardToUrl()
 	require.NoError(t, err)
@@ -526,7 +526,7 @@ func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 }
 
 func (g *generalConfig) AuditLogger() coreconfig.AuditLogger {
-	return auditLoggerConfig{c: g.c.AuditLogger}
+	return auditLoggerConfig{c: g.c.AuditLogger, s: g.secrets.AuditLogger}
 }
 
 func (g *generalConfig) Insecure() config.Insecure {
@@ -542,7 +542,7 @@ func (g *generalConfig) JobDistributor() coreconfig.JobDistributor {
 }
 
 func (g *generalConfig) Password() coreconfig.Password {
-	return &passwordConfig{keystore: g.keystorePassword, vrf: g.vrfPassword}
+	return &passwordConfig{keystore: g.keystorePassword, vrf: g.vrfPassword, keystoreKEK: g.secrets.Password.KeystoreKEK}
 }
 
 func (g *generalConfig) Prometheus() coreconfig.Prometheus {
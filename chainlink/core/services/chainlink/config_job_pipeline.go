@@ -14,6 +14,18 @@ type jobPipelineConfig struct {
 	c toml.JobPipeline
 }
 
+func (j *jobPipelineConfig) CircuitBreakerEnabled() bool {
+	return *j.c.CircuitBreaker.Enabled
+}
+
+func (j *jobPipelineConfig) CircuitBreakerFailureThreshold() uint32 {
+	return *j.c.CircuitBreaker.FailureThreshold
+}
+
+func (j *jobPipelineConfig) CircuitBreakerResetTimeout() time.Duration {
+	return j.c.CircuitBreaker.ResetTimeout.Duration()
+}
+
 func (j *jobPipelineConfig) DefaultHTTPLimit() int64 {
 	return int64(*j.c.HTTPRequest.MaxSize)
 }
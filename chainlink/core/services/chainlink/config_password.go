@@ -1,10 +1,45 @@
 package chainlink
 
+import (
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
 type passwordConfig struct {
-	keystore func() string
-	vrf      func() string
+	keystore    func() string
+	vrf         func() string
+	keystoreKEK toml.KeystoreKEK
 }
 
 func (p *passwordConfig) Keystore() string { return p.keystore() }
 
 func (p *passwordConfig) VRF() string { return p.vrf() }
+
+func (p *passwordConfig) KeystoreKEK() coreconfig.KeystoreKEK {
+	return &keystoreKEKConfig{c: p.keystoreKEK}
+}
+
+type keystoreKEKConfig struct {
+	c toml.KeystoreKEK
+}
+
+func (k *keystoreKEKConfig) Provider() string {
+	if k.c.Provider == nil {
+		return ""
+	}
+	return *k.c.Provider
+}
+
+func (k *keystoreKEKConfig) Path() string {
+	if k.c.Path == nil {
+		return ""
+	}
+	return *k.c.Path
+}
+
+func (k *keystoreKEKConfig) KeyID() string {
+	if k.c.KeyID == nil {
+		return ""
+	}
+	return *k.c.KeyID
+}
@@ -276,10 +276,11 @@ func TestConfig_Marshal(t *testing.T) {
 		{Header: "X-SomeOther-Header", Value: "value with spaces | and a bar+*"},
 	}
 	full.AuditLogger = toml.AuditLogger{
-		Enabled:        ptr(true),
-		ForwardToUrl:   mustURL("http://localhost:9898"),
-		Headers:        ptr(serviceHeaders),
-		JsonWrapperKey: ptr("event"),
+		Enabled:          ptr(true),
+		ForwardToUrl:     mustURL("http://localhost:9898"),
+		Headers:          ptr(serviceHeaders),
+		JsonWrapperKey:   ptr("event"),
+		LocalSinkEnabled: ptr(true),
 	}
 
 	full.Feature = toml.Feature{
@@ -418,6 +419,11 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		CircuitBreaker: toml.JobPipelineCircuitBreaker{
+			Enabled:          ptr(true),
+			FailureThreshold: ptr[uint32](10),
+			ResetTimeout:     commoncfg.MustNewDuration(time.Minute),
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -958,6 +964,7 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+LocalSinkEnabled = true
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -1099,6 +1106,11 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.CircuitBreaker]
+Enabled = true
+FailureThreshold = 10
+ResetTimeout = '1m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1863,6 +1875,16 @@ URL = "postgresql://user:passlocalhost:5432/asdf"
 BackupURL = "foo-bar?password=asdf"
 AllowSimplePasswords = true`,
 			exp: `invalid secrets: Password.Keystore: empty: must be provided and non-empty`},
+
+		{name: "invalid-keystore-kek",
+			toml: `[Database]
+URL = "postgresql://user:passlocalhost:5432/asdf"
+AllowSimplePasswords = true
+[Password.KeystoreKEK]
+Provider = "vault"`,
+			exp: `invalid secrets: Password.KeystoreKEK: 2 errors:
+			- Provider: invalid value (vault): must be one of: file, socket
+			- Path: empty: must be provided and non-empty`},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			var s Secrets
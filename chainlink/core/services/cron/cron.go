@@ -3,6 +3,10 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -13,19 +17,32 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// scheduleParser parses schedules the same way as the runner returned by cronRunner.
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ORM records when a cron job last fired.
+type ORM interface {
+	SetCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error
+}
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+	// running is set while a run is in progress, so that overlapping ticks are skipped.
+	running atomic.Bool
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -41,6 +58,7 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -49,11 +67,16 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.onTick)
 	if err != nil {
 		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
 		return err
 	}
+	if missed := cr.missedRuns(time.Now()); missed > 0 {
+		cr.logger.Infow("Catching up on missed runs", "runs", missed, "policy", cr.jobSpec.CronSpec.MissedTickPolicy)
+		cr.wg.Add(1)
+		go cr.catchUp(missed)
+	}
 	cr.cronRunner.Start()
 	return nil
 }
@@ -62,14 +85,89 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	ctx := cr.cronRunner.Stop()
+	close(cr.chStop)
+	<-ctx.Done()
+	cr.wg.Wait()
 	return nil
 }
 
+// missedRuns returns how many of the ticks scheduled between the last fired time and now should be run,
+// according to the spec's missed tick policy.
+func (cr *Cron) missedRuns(now time.Time) int {
+	spec := cr.jobSpec.CronSpec
+	if spec.LastFiredAt == nil {
+		return 0
+	}
+
+	var limit int
+	switch spec.MissedTickPolicy {
+	case job.MissedTickPolicyRunOnce:
+		limit = 1
+	case job.MissedTickPolicyRunAll:
+		limit = int(spec.MaxCatchUpRuns)
+	default:
+		return 0
+	}
+
+	schedule, err := scheduleParser.Parse(spec.CronSchedule)
+	if err != nil {
+		cr.logger.Errorw("Failed to parse schedule, skipping missed runs", "err", err)
+		return 0
+	}
+	var missed int
+	for next := schedule.Next(*spec.LastFiredAt); !next.IsZero() && !next.After(now) && missed < limit; next = schedule.Next(next) {
+		missed++
+	}
+	return missed
+}
+
+// catchUp runs the pipeline n times in a row, holding the overlap guard so scheduled ticks are skipped meanwhile.
+func (cr *Cron) catchUp(n int) {
+	defer cr.wg.Done()
+	if !cr.running.CompareAndSwap(false, true) {
+		return
+	}
+	defer cr.running.Store(false)
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-cr.chStop:
+			return
+		default:
+		}
+		cr.runPipeline()
+	}
+}
+
+func (cr *Cron) onTick() {
+	// jitter is applied before taking the guard, so that a delayed tick does not
+	// cause the next one to be skipped
+	if jitter := cr.jobSpec.CronSpec.Jitter; jitter > 0 {
+		select {
+		case <-cr.chStop:
+			return
+		case <-time.After(time.Duration(rand.Int63n(int64(jitter)))):
+		}
+	}
+
+	if !cr.running.CompareAndSwap(false, true) {
+		cr.logger.Warn("Previous run is still in progress, skipping tick")
+		return
+	}
+	defer cr.running.Store(false)
+
+	cr.runPipeline()
+}
+
 func (cr *Cron) runPipeline() {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
 
+	if err := cr.orm.SetCronSpecLastFiredAt(ctx, cr.jobSpec.CronSpec.ID, time.Now()); err != nil {
+		cr.logger.Errorw("Failed to record last fired time", "err", err)
+	}
+
 	jobSpec := map[string]any{
 		"databaseID":    cr.jobSpec.ID,
 		"externalJobID": cr.jobSpec.ExternalJobID,
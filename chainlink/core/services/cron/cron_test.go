@@ -1,7 +1,9 @@
 package cron_test
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 )
@@ -38,7 +41,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, jobORM, lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -51,6 +54,32 @@ func TestCronV2Pipeline(t *testing.T) {
 	defer func() { assert.NoError(t, service.Close()) }()
 }
 
+func TestCronV2KeepsLastFiredAt(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	lggr := logger.TestLogger(t)
+	jobORM := job.NewORM(db, pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore, lggr)
+
+	// a replaced job is re-inserted with the last fired time of the old spec
+	lastFiredAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	jb := &job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", LastFiredAt: &lastFiredAt},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	ctx := testutils.Context(t)
+	require.NoError(t, jobORM.CreateJob(ctx, jb))
+
+	found, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.CronSpec.LastFiredAt)
+	assert.True(t, lastFiredAt.Equal(*found.CronSpec.LastFiredAt))
+}
+
 func TestCronV2Schedule(t *testing.T) {
 	t.Parallel()
 
@@ -66,8 +95,10 @@ func TestCronV2Schedule(t *testing.T) {
 		Run(func(args mock.Arguments) { awaiter.ItHappened() }).
 		Return(false, nil).
 		Once()
+	orm := jobmocks.NewORM(t)
+	orm.On("SetCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +106,88 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestCronV2MissedTicks(t *testing.T) {
+	t.Parallel()
+
+	lastFiredAt := time.Now().Add(-3*time.Hour - time.Minute)
+	tests := []struct {
+		name           string
+		policy         job.MissedTickPolicy
+		maxCatchUpRuns uint32
+		lastFiredAt    *time.Time
+		want           int32
+	}{
+		{"skip", job.MissedTickPolicySkip, 0, &lastFiredAt, 0},
+		{"runOnce", job.MissedTickPolicyRunOnce, 0, &lastFiredAt, 1},
+		{"runAll", job.MissedTickPolicyRunAll, 10, &lastFiredAt, 3},
+		{"runAll capped", job.MissedTickPolicyRunAll, 2, &lastFiredAt, 2},
+		{"never fired", job.MissedTickPolicyRunAll, 10, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec: &job.CronSpec{
+					CronSchedule:     "@every 1h",
+					MissedTickPolicy: tt.policy,
+					MaxCatchUpRuns:   tt.maxCatchUpRuns,
+					LastFiredAt:      tt.lastFiredAt,
+				},
+				PipelineSpec: &pipeline.Spec{},
+			}
+			var runs atomic.Int32
+			runner := pipelinemocks.NewRunner(t)
+			runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { runs.Add(1) }).
+				Return(false, nil).
+				Maybe()
+			orm := jobmocks.NewORM(t)
+			orm.On("SetCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+
+			if tt.want > 0 {
+				require.Eventually(t, func() bool { return runs.Load() == tt.want }, testutils.WaitTimeout(t), 10*time.Millisecond)
+			}
+			require.NoError(t, service.Close())
+			assert.Equal(t, tt.want, runs.Load())
+		})
+	}
+}
+
+func TestCronV2SkipsOverlappingTicks(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s"},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	var runs atomic.Int32
+	release := make(chan struct{})
+	runner := pipelinemocks.NewRunner(t)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			runs.Add(1)
+			<-release
+		}).
+		Return(false, nil)
+	orm := jobmocks.NewORM(t)
+	orm.On("SetCronSpecLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	// the first run is still executing across the following ticks
+	require.Never(t, func() bool { return runs.Load() > 1 }, 2500*time.Millisecond, 100*time.Millisecond)
+
+	close(release)
+	require.NoError(t, service.Close())
+}
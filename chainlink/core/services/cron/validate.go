@@ -9,6 +9,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
+// DefaultMaxCatchUpRuns caps the runs of the runAll missed tick policy when the spec does not set maxCatchUpRuns.
+const DefaultMaxCatchUpRuns = 10
+
 func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	var jb = job.Job{
 		ExternalJobID: uuid.New(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
@@ -37,6 +40,34 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if err := validateMissedTickPolicy(&spec); err != nil {
+		return jb, err
+	}
+	if spec.Jitter < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %s", spec.Jitter)
+	}
 
 	return jb, nil
 }
+
+func validateMissedTickPolicy(spec *job.CronSpec) error {
+	switch spec.MissedTickPolicy {
+	case "":
+		spec.MissedTickPolicy = job.MissedTickPolicySkip
+	case job.MissedTickPolicySkip, job.MissedTickPolicyRunOnce, job.MissedTickPolicyRunAll:
+	default:
+		return errors.Errorf("unsupported missedTickPolicy %q, expected one of %q, %q or %q",
+			spec.MissedTickPolicy, job.MissedTickPolicySkip, job.MissedTickPolicyRunOnce, job.MissedTickPolicyRunAll)
+	}
+
+	if spec.MissedTickPolicy != job.MissedTickPolicyRunAll {
+		if spec.MaxCatchUpRuns != 0 {
+			return errors.Errorf("maxCatchUpRuns is only supported with missedTickPolicy %q", job.MissedTickPolicyRunAll)
+		}
+		return nil
+	}
+	if spec.MaxCatchUpRuns == 0 {
+		spec.MaxCatchUpRuns = DefaultMaxCatchUpRuns
+	}
+	return nil
+}
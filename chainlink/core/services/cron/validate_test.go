@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -76,6 +77,75 @@ ds -> ds_parse -> ds_multiply;
 				assert.Contains(t, err.Error(), "invalid cron schedule")
 			},
 		},
+		{
+			name: "missed tick policy defaults",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.MissedTickPolicySkip, s.CronSpec.MissedTickPolicy)
+				assert.Zero(t, s.CronSpec.MaxCatchUpRuns)
+				assert.Zero(t, s.CronSpec.Jitter)
+			},
+		},
+		{
+			name: "runAll with jitter",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+missedTickPolicy = "runAll"
+jitter          = "30s"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.MissedTickPolicyRunAll, s.CronSpec.MissedTickPolicy)
+				assert.Equal(t, uint32(cron.DefaultMaxCatchUpRuns), s.CronSpec.MaxCatchUpRuns)
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter)
+			},
+		},
+		{
+			name: "unsupported missed tick policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+missedTickPolicy = "runTwice"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `unsupported missedTickPolicy "runTwice"`)
+			},
+		},
+		{
+			name: "maxCatchUpRuns without runAll",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+missedTickPolicy = "runOnce"
+maxCatchUpRuns  = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "maxCatchUpRuns is only supported with missedTickPolicy")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
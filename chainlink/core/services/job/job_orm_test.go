@@ -1891,6 +1891,47 @@ func Test_FindSpecErrorsByJobIDs(t *testing.T) {
 	assert.Len(t, specErrs, 2)
 }
 
+func Test_SpecRevisions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	orm := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	first := job.SpecRevision{JobID: 1, TOML: "name = 'a'", Author: "a@chain.link", Comment: "initial"}
+	require.NoError(t, orm.InsertSpecRevision(ctx, &first))
+	assert.Equal(t, int32(1), first.Revision)
+	assert.False(t, first.CreatedAt.IsZero())
+
+	second := job.SpecRevision{JobID: 1, TOML: "name = 'b'"}
+	require.NoError(t, orm.InsertSpecRevision(ctx, &second))
+	assert.Equal(t, int32(2), second.Revision)
+
+	other := job.SpecRevision{JobID: 2, TOML: "name = 'c'"}
+	require.NoError(t, orm.InsertSpecRevision(ctx, &other))
+	assert.Equal(t, int32(1), other.Revision)
+
+	revs, err := orm.FindSpecRevisions(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, int32(2), revs[0].Revision)
+	assert.Equal(t, int32(1), revs[1].Revision)
+
+	rev, err := orm.FindSpecRevision(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "name = 'a'", rev.TOML)
+	assert.Equal(t, "a@chain.link", rev.Author)
+	assert.Equal(t, "initial", rev.Comment)
+
+	_, err = orm.FindSpecRevision(ctx, 1, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func Test_CountPipelineRunsByJobID(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -16,6 +16,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	time "time"
+
 	types "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	uuid "github.com/google/uuid"
@@ -1367,6 +1369,123 @@ func (_c *ORM_FindSpecErrorsByJobIDs_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// FindSpecRevision provides a mock function with given fields: ctx, jobID, revision
+func (_m *ORM) FindSpecRevision(ctx context.Context, jobID int32, revision int32) (job.SpecRevision, error) {
+	ret := _m.Called(ctx, jobID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecRevision")
+	}
+
+	var r0 job.SpecRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) (job.SpecRevision, error)); ok {
+		return rf(ctx, jobID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) job.SpecRevision); ok {
+		r0 = rf(ctx, jobID, revision)
+	} else {
+		r0 = ret.Get(0).(job.SpecRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, jobID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecRevision'
+type ORM_FindSpecRevision_Call struct {
+	*mock.Call
+}
+
+// FindSpecRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - revision int32
+func (_e *ORM_Expecter) FindSpecRevision(ctx interface{}, jobID interface{}, revision interface{}) *ORM_FindSpecRevision_Call {
+	return &ORM_FindSpecRevision_Call{Call: _e.mock.On("FindSpecRevision", ctx, jobID, revision)}
+}
+
+func (_c *ORM_FindSpecRevision_Call) Run(run func(ctx context.Context, jobID int32, revision int32)) *ORM_FindSpecRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecRevision_Call) Return(_a0 job.SpecRevision, _a1 error) *ORM_FindSpecRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecRevision_Call) RunAndReturn(run func(context.Context, int32, int32) (job.SpecRevision, error)) *ORM_FindSpecRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSpecRevisions provides a mock function with given fields: ctx, jobID
+func (_m *ORM) FindSpecRevisions(ctx context.Context, jobID int32) ([]job.SpecRevision, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSpecRevisions")
+	}
+
+	var r0 []job.SpecRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]job.SpecRevision, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []job.SpecRevision); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindSpecRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSpecRevisions'
+type ORM_FindSpecRevisions_Call struct {
+	*mock.Call
+}
+
+// FindSpecRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) FindSpecRevisions(ctx interface{}, jobID interface{}) *ORM_FindSpecRevisions_Call {
+	return &ORM_FindSpecRevisions_Call{Call: _e.mock.On("FindSpecRevisions", ctx, jobID)}
+}
+
+func (_c *ORM_FindSpecRevisions_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_FindSpecRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_FindSpecRevisions_Call) Return(_a0 []job.SpecRevision, _a1 error) *ORM_FindSpecRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindSpecRevisions_Call) RunAndReturn(run func(context.Context, int32) ([]job.SpecRevision, error)) *ORM_FindSpecRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindStandardCapabilityJobID provides a mock function with given fields: ctx, spec
 func (_m *ORM) FindStandardCapabilityJobID(ctx context.Context, spec job.StandardCapabilitiesSpec) (int32, error) {
 	ret := _m.Called(ctx, spec)
@@ -1531,6 +1650,53 @@ func (_c *ORM_InsertJob_Call) RunAndReturn(run func(context.Context, *job.Job) e
 	return _c
 }
 
+// InsertSpecRevision provides a mock function with given fields: ctx, rev
+func (_m *ORM) InsertSpecRevision(ctx context.Context, rev *job.SpecRevision) error {
+	ret := _m.Called(ctx, rev)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSpecRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.SpecRevision) error); ok {
+		r0 = rf(ctx, rev)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_InsertSpecRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSpecRevision'
+type ORM_InsertSpecRevision_Call struct {
+	*mock.Call
+}
+
+// InsertSpecRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rev *job.SpecRevision
+func (_e *ORM_Expecter) InsertSpecRevision(ctx interface{}, rev interface{}) *ORM_InsertSpecRevision_Call {
+	return &ORM_InsertSpecRevision_Call{Call: _e.mock.On("InsertSpecRevision", ctx, rev)}
+}
+
+func (_c *ORM_InsertSpecRevision_Call) Run(run func(ctx context.Context, rev *job.SpecRevision)) *ORM_InsertSpecRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.SpecRevision))
+	})
+	return _c
+}
+
+func (_c *ORM_InsertSpecRevision_Call) Return(_a0 error) *ORM_InsertSpecRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_InsertSpecRevision_Call) RunAndReturn(run func(context.Context, *job.SpecRevision) error) *ORM_InsertSpecRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertWebhookSpec provides a mock function with given fields: ctx, webhookSpec
 func (_m *ORM) InsertWebhookSpec(ctx context.Context, webhookSpec *job.WebhookSpec) error {
 	ret := _m.Called(ctx, webhookSpec)
@@ -1694,6 +1860,102 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SetCronSpecLastFiredAt provides a mock function with given fields: ctx, id, firedAt
+func (_m *ORM) SetCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error {
+	ret := _m.Called(ctx, id, firedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCronSpecLastFiredAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Time) error); ok {
+		r0 = rf(ctx, id, firedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetCronSpecLastFiredAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCronSpecLastFiredAt'
+type ORM_SetCronSpecLastFiredAt_Call struct {
+	*mock.Call
+}
+
+// SetCronSpecLastFiredAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - firedAt time.Time
+func (_e *ORM_Expecter) SetCronSpecLastFiredAt(ctx interface{}, id interface{}, firedAt interface{}) *ORM_SetCronSpecLastFiredAt_Call {
+	return &ORM_SetCronSpecLastFiredAt_Call{Call: _e.mock.On("SetCronSpecLastFiredAt", ctx, id, firedAt)}
+}
+
+func (_c *ORM_SetCronSpecLastFiredAt_Call) Run(run func(ctx context.Context, id int32, firedAt time.Time)) *ORM_SetCronSpecLastFiredAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ORM_SetCronSpecLastFiredAt_Call) Return(_a0 error) *ORM_SetCronSpecLastFiredAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetCronSpecLastFiredAt_Call) RunAndReturn(run func(context.Context, int32, time.Time) error) *ORM_SetCronSpecLastFiredAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobState provides a mock function with given fields: ctx, id, state
+func (_m *ORM) SetJobState(ctx context.Context, id int32, state job.State) error {
+	ret := _m.Called(ctx, id, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.State) error); ok {
+		r0 = rf(ctx, id, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetJobState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobState'
+type ORM_SetJobState_Call struct {
+	*mock.Call
+}
+
+// SetJobState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - state job.State
+func (_e *ORM_Expecter) SetJobState(ctx interface{}, id interface{}, state interface{}) *ORM_SetJobState_Call {
+	return &ORM_SetJobState_Call{Call: _e.mock.On("SetJobState", ctx, id, state)}
+}
+
+func (_c *ORM_SetJobState_Call) Run(run func(ctx context.Context, id int32, state job.State)) *ORM_SetJobState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(job.State))
+	})
+	return _c
+}
+
+func (_c *ORM_SetJobState_Call) Return(_a0 error) *ORM_SetJobState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetJobState_Call) RunAndReturn(run func(context.Context, int32, job.State) error) *ORM_SetJobState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
@@ -1729,6 +1991,53 @@ func (_c *ORM_TryRecordError_Call) RunAndReturn(run func(context.Context, int32,
 	return _c
 }
 
+// ValidateJob provides a mock function with given fields: ctx, jb
+func (_m *ORM) ValidateJob(ctx context.Context, jb *job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_ValidateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateJob'
+type ORM_ValidateJob_Call struct {
+	*mock.Call
+}
+
+// ValidateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+func (_e *ORM_Expecter) ValidateJob(ctx interface{}, jb interface{}) *ORM_ValidateJob_Call {
+	return &ORM_ValidateJob_Call{Call: _e.mock.On("ValidateJob", ctx, jb)}
+}
+
+func (_c *ORM_ValidateJob_Call) Run(run func(ctx context.Context, jb *job.Job)) *ORM_ValidateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *ORM_ValidateJob_Call) Return(_a0 error) *ORM_ValidateJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_ValidateJob_Call) RunAndReturn(run func(context.Context, *job.Job) error) *ORM_ValidateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: source
 func (_m *ORM) WithDataSource(source sqlutil.DataSource) job.ORM {
 	ret := _m.Called(source)
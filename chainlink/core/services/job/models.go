@@ -49,6 +49,7 @@ const (
 	Keeper                  Type = (Type)(pipeline.KeeperJobType)
 	LegacyGasStationServer  Type = (Type)(pipeline.LegacyGasStationServerJobType)
 	LegacyGasStationSidecar Type = (Type)(pipeline.LegacyGasStationSidecarJobType)
+	LogTrigger              Type = (Type)(pipeline.LogTriggerJobType)
 	OffchainReporting       Type = (Type)(pipeline.OffchainReportingJobType)
 	OffchainReporting2      Type = (Type)(pipeline.OffchainReporting2JobType)
 	Stream                  Type = (Type)(pipeline.StreamJobType)
@@ -91,6 +92,7 @@ var (
 		Keeper:                  false, // observationSource is injected in the upkeep executor
 		LegacyGasStationServer:  false,
 		LegacyGasStationSidecar: false,
+		LogTrigger:              true,
 		OffchainReporting2:      false, // bootstrap jobs do not require it
 		OffchainReporting:       false, // bootstrap jobs do not require it
 		Stream:                  true,
@@ -112,6 +114,7 @@ var (
 		Keeper:                  true,
 		LegacyGasStationServer:  false,
 		LegacyGasStationSidecar: false,
+		LogTrigger:              true,
 		OffchainReporting2:      false,
 		OffchainReporting:       false,
 		Stream:                  true,
@@ -133,6 +136,7 @@ var (
 		Keeper:                  1,
 		LegacyGasStationServer:  1,
 		LegacyGasStationSidecar: 1,
+		LogTrigger:              1,
 		OffchainReporting2:      1,
 		OffchainReporting:       1,
 		Stream:                  1,
@@ -143,6 +147,15 @@ var (
 	}
 )
 
+// State is whether the job's services are running. Paused jobs keep their
+// spec and run history, but their services are not started.
+type State string
+
+const (
+	StateActive State = "active"
+	StatePaused State = "paused"
+)
+
 type Job struct {
 	ID                            int32     `toml:"-"`
 	ExternalJobID                 uuid.UUID `toml:"externalJobID"`
@@ -191,6 +204,8 @@ type Job struct {
 	CCIPBootstrapSpecID           *int32
 	CRESettingsSpecID             *int32
 	CRESettingsSpec               *CRESettingsSpec
+	LogTriggerSpecID              *int32
+	LogTriggerSpec                *LogTriggerSpec
 	JobSpecErrors                 []SpecError
 	Type                          Type          `toml:"type"`
 	SchemaVersion                 uint32        `toml:"schemaVersion"`
@@ -199,6 +214,7 @@ type Job struct {
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               sqlutil.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
+	State                         State             `toml:"-"`
 	CreatedAt                     time.Time
 }
 
@@ -240,6 +256,18 @@ type PipelineSpec struct {
 	IsPrimary      bool  `json:"is_primary"`
 }
 
+// SpecRevision is an accepted TOML revision of a job spec. Revisions are
+// numbered from 1 per job and are kept when the job is updated.
+type SpecRevision struct {
+	ID        int64
+	JobID     int32
+	Revision  int32
+	TOML      string `db:"toml"`
+	Author    string
+	Comment   string
+	CreatedAt time.Time
+}
+
 type SpecError struct {
 	ID          int64
 	JobID       int32
@@ -474,11 +502,54 @@ type ExternalInitiatorWebhookSpec struct {
 	Spec                models.JSON
 }
 
+// LogTriggerSpec defines a job which starts a pipeline run for every confirmed log emitted by ContractAddress
+// that matches EventABI and the topic filters.
+type LogTriggerSpec struct {
+	ID              int32                 `toml:"-"`
+	ContractAddress evmtypes.EIP55Address `toml:"contractAddress"`
+	// EventABI is the event signature with argument names, e.g. "Transfer(address indexed from, address indexed to, uint256 value)".
+	EventABI string `toml:"eventABI"`
+	// Topic1, Topic2 and Topic3 restrict the values of the first, second and third indexed arguments. Empty matches any value.
+	Topic1           pq.StringArray `toml:"topic1"`
+	Topic2           pq.StringArray `toml:"topic2"`
+	Topic3           pq.StringArray `toml:"topic3"`
+	MinConfirmations uint32         `toml:"minConfirmations"`
+	// FromBlock is the first block scanned when the job has not processed any block yet. By default, only logs
+	// emitted after the job was started are processed.
+	FromBlock  int64         `toml:"fromBlock"`
+	PollPeriod time.Duration `toml:"pollPeriod"`
+	EVMChainID *big.Big      `toml:"evmChainID"`
+	// CursorBlock and CursorLogIndex locate the next log to process, so that the job resumes from it on restart.
+	CursorBlock    null.Int  `toml:"-"`
+	CursorLogIndex int64     `toml:"-"`
+	CreatedAt      time.Time `toml:"-"`
+	UpdatedAt      time.Time `toml:"-"`
+}
+
+func (s LogTriggerSpec) GetID() string {
+	return strconv.Itoa(int(s.ID))
+}
+
+func (s *LogTriggerSpec) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	s.ID = int32(ID)
+	return nil
+}
+
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SigningSecret, when set, allows third parties to run the job with requests signed by this HMAC secret.
+	SigningSecret null.String `json:"-" toml:"-"`
+	// SignatureTolerance bounds how far the timestamp of a signed request may be from the node's clock.
+	SignatureTolerance time.Duration `json:"-" toml:"-"`
+	// RequestSchema is an optional JSON schema which the body of signed requests must satisfy.
+	RequestSchema null.String `json:"-" toml:"-"`
+	CreatedAt     time.Time   `json:"createdAt" toml:"-"`
+	UpdatedAt     time.Time   `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -505,12 +576,28 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// MissedTickPolicy decides what a cron job does about the ticks it missed while the node was down.
+type MissedTickPolicy string
+
+const (
+	// MissedTickPolicySkip drops missed ticks and waits for the next scheduled one.
+	MissedTickPolicySkip MissedTickPolicy = "skip"
+	// MissedTickPolicyRunOnce runs the pipeline once on start if at least one tick was missed.
+	MissedTickPolicyRunOnce MissedTickPolicy = "runOnce"
+	// MissedTickPolicyRunAll runs the pipeline once per missed tick, up to MaxCatchUpRuns.
+	MissedTickPolicyRunAll MissedTickPolicy = "runAll"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID               int32            `toml:"-"`
+	CronSchedule     string           `toml:"schedule"`
+	EVMChainID       *big.Big         `toml:"evmChainID"`
+	MissedTickPolicy MissedTickPolicy `toml:"missedTickPolicy"`
+	MaxCatchUpRuns   uint32           `toml:"maxCatchUpRuns"`
+	Jitter           time.Duration    `toml:"jitter"`
+	LastFiredAt      *time.Time       `toml:"-"`
+	CreatedAt        time.Time        `toml:"-"`
+	UpdatedAt        time.Time        `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -45,6 +45,8 @@ type ORM interface {
 	InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) error
 	InsertJob(ctx context.Context, job *Job) error
 	CreateJob(ctx context.Context, jb *Job) error
+	// ValidateJob runs every check of CreateJob, including the inserts, and then discards the inserted records.
+	ValidateJob(ctx context.Context, jb *Job) error
 	FindJobs(ctx context.Context, offset, limit int) ([]Job, int, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
@@ -52,11 +54,20 @@ type ORM interface {
 	FindOCR2JobIDByAddress(ctx context.Context, relay string, chainID int64, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
+	// SetJobState persists whether the job is active or paused. It returns sql.ErrNoRows if the job does not exist.
+	SetJobState(ctx context.Context, id int32, state State) error
+	// SetCronSpecLastFiredAt records when a cron job last fired, so that missed ticks can be computed on restart.
+	SetCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
 	DismissError(ctx context.Context, errorID int64) error
 	FindSpecError(ctx context.Context, id int64) (SpecError, error)
+	// InsertSpecRevision records an accepted spec revision, numbering it after the job's latest revision.
+	InsertSpecRevision(ctx context.Context, rev *SpecRevision) error
+	// FindSpecRevisions returns the spec revisions of a job, newest first.
+	FindSpecRevisions(ctx context.Context, jobID int32) ([]SpecRevision, error)
+	FindSpecRevision(ctx context.Context, jobID int32, revision int32) (SpecRevision, error)
 	Close() error
 	PipelineRuns(ctx context.Context, jobID *int32, offset, size int) ([]pipeline.Run, int, error)
 
@@ -167,6 +178,22 @@ func (o *orm) AssertBridgesExist(ctx context.Context, p pipeline.Pipeline) error
 	return nil
 }
 
+// ValidateJob creates jb inside a savepoint which is always rolled back, so that database constraints are
+// checked along with the key and relay checks of CreateJob without persisting anything. Like CreateJob,
+// it scans the inserted records back into jb.
+func (o *orm) ValidateJob(ctx context.Context, jb *Job) error {
+	return o.transact(ctx, false, func(tx *orm) error {
+		if _, err := tx.ds.ExecContext(ctx, `SAVEPOINT validate_job`); err != nil {
+			return errors.Wrap(err, "failed to create savepoint")
+		}
+		err := tx.CreateJob(ctx, jb)
+		if _, rerr := tx.ds.ExecContext(ctx, `ROLLBACK TO SAVEPOINT validate_job`); rerr != nil {
+			return stderrors.Join(err, errors.Wrap(rerr, "failed to roll back savepoint"))
+		}
+		return err
+	})
+}
+
 // CreateJob creates the job, and it's associated spec record.
 // Expects an unmarshalled job spec as the jb argument i.e. output from ValidatedXX.
 // Scans all persisted records back into jb
@@ -465,6 +492,15 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 				return fmt.Errorf("failed to create GatewaySpec for jobSpec: %w", err)
 			}
 			jb.GatewaySpecID = &specID
+		case LogTrigger:
+			if jb.LogTriggerSpec.EVMChainID == nil {
+				return errors.New("evm chain id must be defined")
+			}
+			specID, err := tx.insertLogTriggerSpec(ctx, jb.LogTriggerSpec)
+			if err != nil {
+				return errors.Wrap(err, "failed to create LogTriggerSpec for jobSpec")
+			}
+			jb.LogTriggerSpecID = &specID
 		case Stream:
 			// 'stream' type has no associated spec, nothing to do here
 		case Workflow:
@@ -591,11 +627,19 @@ func (o *orm) insertCRESettingsSpec(ctx context.Context, spec *CRESettingsSpec)
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	if spec.MissedTickPolicy == "" {
+		spec.MissedTickPolicy = MissedTickPolicySkip
+	}
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, missed_tick_policy, max_catch_up_runs, jitter, last_fired_at, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :missed_tick_policy, :max_catch_up_runs, :jitter, :last_fired_at, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
+func (o *orm) SetCronSpecLastFiredAt(ctx context.Context, id int32, firedAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE cron_specs SET last_fired_at = $1, updated_at = NOW() WHERE id = $2`, firedAt, id)
+	return errors.Wrap(err, "failed to set cron spec last fired at")
+}
+
 func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, err error) {
 	return o.prepareQuerySpecID(ctx, `INSERT INTO vrf_specs (
 				coordinator_address, public_key, min_incoming_confirmations,
@@ -648,6 +692,12 @@ func (o *orm) insertBootstrapSpec(ctx context.Context, spec *BootstrapSpec) (spe
 			RETURNING id;`, spec)
 }
 
+func (o *orm) insertLogTriggerSpec(ctx context.Context, spec *LogTriggerSpec) (specID int32, err error) {
+	return o.prepareQuerySpecID(ctx, `INSERT INTO log_trigger_specs (contract_address, event_abi, topic1, topic2, topic3, min_confirmations, from_block, poll_period, evm_chain_id, cursor_block, cursor_log_index, created_at, updated_at)
+			VALUES (:contract_address, :event_abi, :topic1, :topic2, :topic3, :min_confirmations, :from_block, :poll_period, :evm_chain_id, :cursor_block, :cursor_log_index, NOW(), NOW())
+			RETURNING id;`, spec)
+}
+
 func (o *orm) insertGatewaySpec(ctx context.Context, spec *GatewaySpec) (specID int32, err error) {
 	return o.prepareQuerySpecID(ctx, `INSERT INTO gateway_specs (gateway_config, created_at, updated_at)
 			VALUES (:gateway_config, NOW(), NOW())
@@ -733,8 +783,8 @@ func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Maste
 }
 
 func (o *orm) InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) error {
-	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (signing_secret, signature_tolerance, request_schema, created_at, updated_at)
+			VALUES (:signing_secret, :signature_tolerance, :request_schema, NOW(), NOW())
 			RETURNING *;`, webhookSpec)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -743,6 +793,9 @@ func (o *orm) InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) e
 }
 
 func (o *orm) InsertJob(ctx context.Context, job *Job) error {
+	if job.State == "" {
+		job.State = StateActive
+	}
 	return o.transact(ctx, false, func(tx *orm) error {
 		var query string
 
@@ -750,18 +803,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, log_trigger_spec_id, external_job_id, gas_limit, forwarding_allowed, state, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :log_trigger_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :state, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, log_trigger_spec_id, external_job_id, gas_limit, forwarding_allowed, state, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :log_trigger_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :state, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -800,6 +853,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 		Workflow:             `DELETE FROM workflow_specs WHERE id in (SELECT workflow_spec_id FROM deleted_jobs)`,
 		StandardCapabilities: `DELETE FROM standardcapabilities_specs WHERE id in (SELECT standard_capabilities_spec_id FROM deleted_jobs)`,
 		CCIP:                 `DELETE FROM ccip_specs WHERE id in (SELECT ccip_spec_id FROM deleted_jobs)`,
+		LogTrigger:           `DELETE FROM log_trigger_specs WHERE id in (SELECT log_trigger_spec_id FROM deleted_jobs)`,
 		Stream:               ``,
 	}
 	q, ok := queries[jobType]
@@ -831,6 +885,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 				workflow_spec_id,
 				standard_capabilities_spec_id,
 				ccip_spec_id,
+				log_trigger_spec_id,
 				stream_id
 		),`
 	if len(q) > 0 {
@@ -895,6 +950,21 @@ func (o *orm) DismissError(ctx context.Context, ID int64) error {
 	return nil
 }
 
+func (o *orm) SetJobState(ctx context.Context, id int32, state State) error {
+	res, err := o.ds.ExecContext(ctx, `UPDATE jobs SET state = $1 WHERE id = $2`, state, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to set job state")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to set job state")
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	stmt := `SELECT * FROM job_spec_errors WHERE id = $1;`
 
@@ -904,6 +974,44 @@ func (o *orm) FindSpecError(ctx context.Context, id int64) (SpecError, error) {
 	return *specErr, errors.Wrap(err, "FindSpecError failed")
 }
 
+func (o *orm) InsertSpecRevision(ctx context.Context, rev *SpecRevision) error {
+	err := o.transact(ctx, false, func(tx *orm) error {
+		// the job row is locked, so that concurrent revisions of the job are numbered one after the other
+		var jobID int32
+		if err := tx.ds.GetContext(ctx, &jobID, `SELECT id FROM jobs WHERE id = $1 FOR UPDATE`, rev.JobID); err != nil {
+			return errors.Wrap(err, "failed to lock job")
+		}
+		stmt := `INSERT INTO job_spec_revisions (job_id, revision, toml, author, comment, created_at)
+			SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, $4, NOW() FROM job_spec_revisions WHERE job_id = $1
+			RETURNING *;`
+		return tx.ds.GetContext(ctx, rev, stmt, rev.JobID, RedactSecrets(rev.TOML), rev.Author, rev.Comment)
+	})
+	return errors.Wrap(err, "InsertSpecRevision failed")
+}
+
+func (o *orm) FindSpecRevisions(ctx context.Context, jobID int32) ([]SpecRevision, error) {
+	stmt := `SELECT * FROM job_spec_revisions WHERE job_id = $1 ORDER BY revision DESC;`
+
+	var revs []SpecRevision
+	err := o.ds.SelectContext(ctx, &revs, stmt, jobID)
+	// revisions recorded before secrets were redacted may still contain them
+	for i := range revs {
+		revs[i].TOML = RedactSecrets(revs[i].TOML)
+	}
+
+	return revs, errors.Wrap(err, "FindSpecRevisions failed")
+}
+
+func (o *orm) FindSpecRevision(ctx context.Context, jobID int32, revision int32) (SpecRevision, error) {
+	stmt := `SELECT * FROM job_spec_revisions WHERE job_id = $1 AND revision = $2;`
+
+	var rev SpecRevision
+	err := o.ds.GetContext(ctx, &rev, stmt, jobID, revision)
+	rev.TOML = RedactSecrets(rev.TOML)
+
+	return rev, errors.Wrap(err, "FindSpecRevision failed")
+}
+
 func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, count int, err error) {
 	err = o.transact(ctx, false, func(tx *orm) error {
 		sql := `SELECT count(*) FROM jobs;`
@@ -1547,6 +1655,7 @@ func (o *orm) loadAllJobTypes(ctx context.Context, job *Job) error {
 		o.loadJobType(ctx, job, "WorkflowSpec", "workflow_specs", job.WorkflowSpecID),
 		o.loadJobType(ctx, job, "StandardCapabilitiesSpec", "standardcapabilities_specs", job.StandardCapabilitiesSpecID),
 		o.loadJobType(ctx, job, "CCIPSpec", "ccip_specs", job.CCIPSpecID),
+		o.loadJobType(ctx, job, "LogTriggerSpec", "log_trigger_specs", job.LogTriggerSpecID),
 	)
 }
 
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
 	"sync"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 
@@ -28,6 +30,14 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// PauseJob stops the job's services without deleting it, and persists it
+		// as paused so that its services are not started until it is resumed.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob persists the job as active and starts its services.
+		ResumeJob(ctx context.Context, jobID int32) error
+		// ReplaceJob deletes the job with jb's ID and creates jb in its place,
+		// keeping the job's state, so that a paused job stays paused.
+		ReplaceJob(ctx context.Context, jb *Job) error
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -50,6 +60,7 @@ type (
 		jobTypeDelegates map[Type]Delegate
 		activeJobs       map[int32]activeJob
 		activeJobsMu     sync.RWMutex
+		jobStateMu       sync.Mutex // serializes deleting, replacing, pausing and resuming jobs
 		lggr             logger.Logger
 
 		chStop              services.StopChan
@@ -133,6 +144,8 @@ func (js *spawner) startAllServices(ctx context.Context) {
 		js.SvcErrBuffer.Append(werr)
 		return
 	}
+	// paused jobs are not started until they are resumed
+	jbs = slices.DeleteFunc(jbs, func(jb Job) bool { return jb.State == StatePaused })
 
 	jobIDs := make([]int32, len(jbs))
 	for i, jb := range jbs {
@@ -269,8 +282,9 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
 	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(ctx, *jb)
-	if err != nil {
+	if jb.State == StatePaused {
+		js.lggr.Infow("Not starting services of paused job", "type", jb.Type, "jobID", jb.ID)
+	} else if err = js.StartService(ctx, *jb); err != nil {
 		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
 	} else {
 		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
@@ -283,6 +297,12 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 
 // Should not get called before Start()
 func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
+	js.jobStateMu.Lock()
+	defer js.jobStateMu.Unlock()
+	return js.deleteJob(ctx, ds, jobID)
+}
+
+func (js *spawner) deleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	if ds == nil {
 		ds = js.orm.DataSource()
 	}
@@ -346,6 +366,111 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) ReplaceJob(ctx context.Context, jb *Job) error {
+	js.jobStateMu.Lock()
+	defer js.jobStateMu.Unlock()
+
+	old, err := js.orm.FindJob(ctx, jb.ID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jb.ID)
+	}
+	if err = js.deleteJob(ctx, nil, jb.ID); err != nil {
+		return err
+	}
+	jb.State = old.State
+	// the spec is re-inserted, so carry over when a cron job last fired to
+	// preserve its missed tick handling, and the cursor of a log trigger job
+	// so that it neither skips nor reprocesses logs
+	if old.CronSpec != nil && jb.CronSpec != nil {
+		jb.CronSpec.LastFiredAt = old.CronSpec.LastFiredAt
+	}
+	if old.LogTriggerSpec != nil && jb.LogTriggerSpec != nil {
+		jb.LogTriggerSpec.CursorBlock = old.LogTriggerSpec.CursorBlock
+		jb.LogTriggerSpec.CursorLogIndex = old.LogTriggerSpec.CursorLogIndex
+	}
+	return js.CreateJob(ctx, nil, jb)
+}
+
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	js.jobStateMu.Lock()
+	defer js.jobStateMu.Unlock()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to find job %d: %w", jobID, err)
+	}
+	// The services are stopped before the state is saved, and restarted if
+	// saving fails, so that a job saved as paused is never running.
+	wasActive := js.isActive(jobID)
+	if wasActive {
+		js.stopService(jobID)
+	}
+	if err = js.orm.SetJobState(ctx, jobID, StatePaused); err != nil {
+		if wasActive {
+			if serr := js.StartService(ctx, jb); serr != nil {
+				js.lggr.Errorw("Error restarting services of job which failed to pause", "type", jb.Type, "jobID", jobID, "err", serr)
+			}
+		}
+		return fmt.Errorf("failed to pause job %d: %w", jobID, err)
+	}
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	js.jobStateMu.Lock()
+	defer js.jobStateMu.Unlock()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to find job %d: %w", jobID, err)
+	}
+	if js.isActive(jobID) {
+		return js.setState(ctx, jobID, StateActive)
+	}
+	jb.State = StateActive
+	// the ticks of a cron job missed while it was paused are not caught up, only
+	// those missed while the node was down
+	if jb.CronSpec != nil {
+		now := time.Now()
+		if err = js.orm.SetCronSpecLastFiredAt(ctx, jb.CronSpec.ID, now); err != nil {
+			return fmt.Errorf("failed to reset last fired time of job %d: %w", jobID, err)
+		}
+		jb.CronSpec.LastFiredAt = &now
+	}
+	// As in PauseJob, the job is only saved as active once its services have started.
+	if err = js.StartService(ctx, jb); err != nil {
+		js.lggr.Errorw("Error starting resumed job services", "type", jb.Type, "jobID", jobID, "err", err)
+		if js.isActive(jobID) {
+			js.stopService(jobID)
+		}
+		return err
+	}
+	if err = js.setState(ctx, jobID, StateActive); err != nil {
+		js.stopService(jobID)
+		return err
+	}
+	js.lggr.Infow("Resumed job", "type", jb.Type, "jobID", jobID)
+	return nil
+}
+
+func (js *spawner) setState(ctx context.Context, jobID int32, state State) error {
+	if err := js.orm.SetJobState(ctx, jobID, state); err != nil {
+		return fmt.Errorf("failed to set state of job %d to %s: %w", jobID, state, err)
+	}
+	return nil
+}
+
+func (js *spawner) isActive(jobID int32) bool {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	_, exists := js.activeJobs[jobID]
+	return exists
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -280,6 +281,102 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops and restarts job services on 'PauseJob()'/'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA2.On("Start", mock.Anything).Return(nil).Twice()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, job.StatePaused, jb.State)
+		assert.Equal(t, jobA.ExternalJobID, jb.ExternalJobID)
+
+		// paused jobs are not started with the spawner
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+		require.NoError(t, spawner.Start(ctx))
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, job.StateActive, jb.State)
+
+		// resuming an active job does not start its services again
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.Close())
+
+		require.ErrorIs(t, spawner.PauseJob(ctx, 999999), sql.ErrNoRows)
+
+		clearDB(t, db)
+	})
+
+	t.Run("keeps a paused job paused on 'ReplaceJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		// the service is never started while the job is paused
+		serviceA := mocks.NewServiceCtx(t)
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, spawner.Start(ctx))
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+
+		replacement := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		replacement.ID = jobA.ID
+		require.NoError(t, spawner.ReplaceJob(ctx, replacement))
+		assert.Equal(t, job.StatePaused, replacement.State)
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, job.StatePaused, jb.State)
+		assert.Equal(t, replacement.ExternalJobID, jb.ExternalJobID)
+
+		require.ErrorIs(t, spawner.ReplaceJob(ctx, &job.Job{ID: 999999}), sql.ErrNoRows)
+
+		require.NoError(t, spawner.Close())
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
@@ -1,10 +1,15 @@
 package job
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 var (
@@ -23,6 +28,7 @@ var (
 		Keeper:                  {},
 		LegacyGasStationServer:  {},
 		LegacyGasStationSidecar: {},
+		LogTrigger:              {},
 		OffchainReporting2:      {},
 		OffchainReporting:       {},
 		Stream:                  {},
@@ -76,3 +82,323 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// ExpandFragments returns tomlString with the fragments included by its observationSource
+// expanded with resolve, so that a spec which is parsed several times is only expanded once.
+// Only the observationSource is rewritten. Specs without fragments are returned unchanged.
+func ExpandFragments(tomlString string, resolve pipeline.FragmentResolver) (string, error) {
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		// invalid TOML is reported by ValidateSpec
+		return tomlString, nil //nolint:nilerr
+	}
+	source, ok := tree.Get("observationSource").(string)
+	if !ok {
+		return tomlString, nil
+	}
+	expanded, err := pipeline.ExpandFragments(source, resolve)
+	if err != nil {
+		return "", err
+	}
+	return ReplaceObservationSource(tomlString, expanded)
+}
+
+// ReplaceObservationSource returns tomlString with the value of its observationSource set to source. Only
+// the string is rewritten, so the rest of the spec keeps its formatting and comments.
+func ReplaceObservationSource(tomlString, source string) (string, error) {
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return "", err
+	}
+	current, ok := tree.Get("observationSource").(string)
+	if !ok {
+		return "", errors.New("spec has no observationSource")
+	}
+	if current == source {
+		return tomlString, nil
+	}
+	start, end, ok := observationSourceSpan(tomlString)
+	if !ok {
+		return "", errors.New("failed to locate observationSource")
+	}
+	replaced := tomlString[:start] + multilineTOMLString(source) + tomlString[end:]
+	if tree, err = toml.Load(replaced); err != nil || tree.Get("observationSource") != source {
+		return "", errors.New("failed to replace observationSource")
+	}
+	return replaced, nil
+}
+
+// observationSourceSpan returns the offsets of the observationSource string in tomlString, delimiters
+// included.
+func observationSourceSpan(tomlString string) (start, end int, ok bool) {
+	offset := 0
+	for _, line := range strings.SplitAfter(tomlString, "\n") {
+		m := observationSourceKey.FindStringSubmatchIndex(strings.TrimSuffix(line, "\n"))
+		if m == nil {
+			offset += len(line)
+			continue
+		}
+		start = offset + m[2]
+		delim := line[m[2]:m[3]]
+		rest := tomlString[start+len(delim):]
+		basic := delim[0] == '"'
+		for i := 0; i < len(rest); i++ {
+			if basic && rest[i] == '\\' {
+				i++
+				continue
+			}
+			if strings.HasPrefix(rest[i:], delim) {
+				return start, start + len(delim) + i + len(delim), true
+			}
+		}
+		return 0, 0, false
+	}
+	return 0, 0, false
+}
+
+// multilineTOMLString returns s as a multi-line basic TOML string.
+func multilineTOMLString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r < 0x20 && r != '\n' && r != '\t' || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	escaped := strings.ReplaceAll(b.String(), `"""`, `""\"`)
+	if strings.HasSuffix(escaped, `"`) {
+		escaped = escaped[:len(escaped)-1] + `\"`
+	}
+	return "\"\"\"\n" + escaped + `"""`
+}
+
+// RedactedSecret replaces the values of secret fields in recorded spec revisions.
+const RedactedSecret = "<redacted>"
+
+// secretSpecFields are the spec fields whose values are not kept in spec revisions.
+var secretSpecFields = []string{"signingSecret"}
+
+// RedactSecrets replaces the values of the secret fields of tomlString with RedactedSecret.
+func RedactSecrets(tomlString string) string {
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return tomlString
+	}
+	var redacted bool
+	for _, field := range secretSpecFields {
+		if v, ok := tree.Get(field).(string); ok && v != "" && v != RedactedSecret {
+			tree.Set(field, RedactedSecret)
+			redacted = true
+		}
+	}
+	if !redacted {
+		return tomlString
+	}
+	if source, ok := tree.Get("observationSource").(string); ok {
+		tree.SetWithOptions("observationSource", toml.SetOptions{Multiline: true}, source)
+	}
+	return tree.String()
+}
+
+// RestoreSecrets replaces the redacted secret fields of tomlString with the secrets of current,
+// so that a spec revision can be applied again.
+func RestoreSecrets(tomlString string, current Job) (string, error) {
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		// invalid TOML is reported by ValidateSpec
+		return tomlString, nil //nolint:nilerr
+	}
+	if v, ok := tree.Get("signingSecret").(string); !ok || v != RedactedSecret {
+		return tomlString, nil
+	}
+	if current.WebhookSpec == nil || !current.WebhookSpec.SigningSecret.Valid {
+		return "", errors.New("signingSecret is redacted in the revision and the job has no signingSecret to keep")
+	}
+	tree.Set("signingSecret", current.WebhookSpec.SigningSecret.String)
+	if source, ok := tree.Get("observationSource").(string); ok {
+		tree.SetWithOptions("observationSource", toml.SetOptions{Multiline: true}, source)
+	}
+	return tree.String(), nil
+}
+
+// Stages of job spec validation, in the order they run.
+const (
+	ValidationStageTOML     = "toml"
+	ValidationStageSpec     = "spec"
+	ValidationStagePipeline = "pipeline"
+	ValidationStageBridges  = "bridges"
+	ValidationStageJob      = "job"
+)
+
+// minReferencedValueLength is the shortest quoted value that is matched against an error
+// message to locate it, so that short values like "1" are not found everywhere.
+const minReferencedValueLength = 3
+
+var (
+	tomlErrorPosition    = regexp.MustCompile(`\((\d+), (\d+)\):`)
+	dotErrorPosition     = regexp.MustCompile(`(\d+):(\d+): error:`)
+	observationSourceKey = regexp.MustCompile(`^\s*observationSource\s*=\s*("""|'''|"|')(.*)$`)
+	quotedValue          = regexp.MustCompile(`"([^"]+)"|'([^']+)'|<([^<>]+)>`)
+	dotDeclaration       = regexp.MustCompile(`^\s*(?:"([^"]+)"|([\w-]+))\s*\[`)
+)
+
+// ValidationError is a problem found while validating a job spec. Line and Column are 1-based
+// positions in the TOML source, and are zero when the problem could not be located.
+type ValidationError struct {
+	Stage   string `json:"stage"`
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+}
+
+// NewValidationErrors converts an error returned by a validation stage into ValidationErrors, one
+// for each of the errors it joins. submitted is the spec as it was submitted and expanded the spec
+// which was validated, with the fragments included by its observationSource expanded. Errors are
+// located in expanded from the position reported by the TOML or DOT parser, or otherwise from the
+// line of the longest quoted value that the error message refers to, and their positions mapped
+// back to submitted.
+func NewValidationErrors(submitted, expanded string, stage string, err error) []ValidationError {
+	var verrs []ValidationError
+	for _, err := range splitErrors(err) {
+		verrs = append(verrs, newValidationError(submitted, expanded, stage, err))
+	}
+	return verrs
+}
+
+// splitErrors returns the errors joined by err, recursively, or err itself.
+func splitErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, err := range joined.Unwrap() {
+		if err != nil {
+			errs = append(errs, splitErrors(err)...)
+		}
+	}
+	return errs
+}
+
+func newValidationError(submitted, expanded string, stage string, err error) ValidationError {
+	verr := ValidationError{Stage: stage, Message: err.Error()}
+	if m := dotErrorPosition.FindStringSubmatch(verr.Message); m != nil {
+		verr.Stage = ValidationStagePipeline
+		if start, ok := observationSourceStart(expanded); ok {
+			// pipeline.Parse wraps the source in a digraph, which adds a line before it.
+			line, _ := strconv.Atoi(m[1])
+			verr.Line, _ = mapExpandedLine(submitted, expanded, start+line-2)
+		}
+		return verr
+	}
+	if m := tomlErrorPosition.FindStringSubmatch(verr.Message); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		var exact bool
+		if verr.Line, exact = mapExpandedLine(submitted, expanded, line); exact {
+			verr.Column, _ = strconv.Atoi(m[2])
+		}
+		return verr
+	}
+	if line, column, ok := locateQuotedValue(submitted, verr.Message); ok {
+		verr.Line, verr.Column = line, column
+	} else if line, column, ok = locateQuotedValue(expanded, verr.Message); ok {
+		var exact bool
+		if verr.Line, exact = mapExpandedLine(submitted, expanded, line); exact {
+			verr.Column = column
+		}
+	}
+	return verr
+}
+
+// locateQuotedValue returns the position in tomlString of the longest quoted value, or DOT value in
+// angle brackets, which message contains.
+func locateQuotedValue(tomlString, message string) (line, column int, ok bool) {
+	var longest string
+	for i, l := range strings.Split(tomlString, "\n") {
+		for _, m := range quotedValue.FindAllStringSubmatch(l, -1) {
+			value := m[1] + m[2] + m[3]
+			if len(value) < minReferencedValueLength || len(value) <= len(longest) || !strings.Contains(message, value) {
+				continue
+			}
+			longest = value
+			line, column = i+1, strings.Index(l, value)+1
+		}
+	}
+	return line, column, longest != ""
+}
+
+// mapExpandedLine maps a line of expanded to the line of submitted it comes from. Only the
+// observationSource differs between them: lines before it are the same, lines after it are shifted,
+// and lines within it are mapped to the line of submitted declaring the same task, or the fragment
+// node it was expanded from. exact is false if the line was mapped to a different line content.
+func mapExpandedLine(submitted, expanded string, line int) (mapped int, exact bool) {
+	if submitted == expanded {
+		return line, true
+	}
+	subStart, subEnd, ok := observationSourceLines(submitted)
+	if !ok {
+		return line, true
+	}
+	expStart, expEnd, ok := observationSourceLines(expanded)
+	if !ok {
+		return line, true
+	}
+	switch {
+	case line < expStart:
+		return line, true
+	case line > expEnd:
+		return line - expEnd + subEnd, true
+	}
+	expandedLines := strings.Split(expanded, "\n")
+	m := dotDeclaration.FindStringSubmatch(expandedLines[line-1])
+	if m == nil {
+		return subStart, false
+	}
+	dotID := m[1] + m[2]
+	submittedLines := strings.Split(submitted, "\n")
+	// tasks of a fragment are named after the node including it, e.g. prices or prices_fetch
+	for name := dotID; name != ""; {
+		for i := subStart; i <= subEnd && i <= len(submittedLines); i++ {
+			if m := dotDeclaration.FindStringSubmatch(submittedLines[i-1]); m != nil && m[1]+m[2] == name {
+				return i, false
+			}
+		}
+		cut := strings.LastIndex(name, "_")
+		if cut < 0 {
+			break
+		}
+		name = name[:cut]
+	}
+	return subStart, false
+}
+
+// observationSourceLines returns the lines of tomlString on which the observationSource value starts
+// and ends.
+func observationSourceLines(tomlString string) (start, end int, ok bool) {
+	from, to, ok := observationSourceSpan(tomlString)
+	if !ok {
+		return 0, 0, false
+	}
+	start = strings.Count(tomlString[:from], "\n") + 1
+	return start, start + strings.Count(tomlString[from:to], "\n"), true
+}
+
+// observationSourceStart returns the line of tomlString on which the observationSource value starts.
+func observationSourceStart(tomlString string) (int, bool) {
+	for i, line := range strings.Split(tomlString, "\n") {
+		m := observationSourceKey.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		// A newline immediately after the opening delimiter of a multi-line string is trimmed.
+		if len(m[1]) == 3 && strings.TrimSpace(m[2]) == "" {
+			return i + 2, true
+		}
+		return i + 1, true
+	}
+	return 0, false
+}
@@ -1,10 +1,17 @@
 package job
 
 import (
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestValidate(t *testing.T) {
@@ -111,3 +118,236 @@ ds [type=http]
 		})
 	}
 }
+
+func TestNewValidationErrors(t *testing.T) {
+	// newValidationError returns the single ValidationError of a spec without fragments.
+	newValidationError := func(t *testing.T, spec string, stage string, err error) ValidationError {
+		verrs := NewValidationErrors(spec, spec, stage, err)
+		require.Len(t, verrs, 1)
+		return verrs[0]
+	}
+
+	t.Run("TOML syntax error", func(t *testing.T) {
+		spec := "type = \"webhook\"\nschemaVersion = = 1\n"
+		_, err := ValidateSpec(spec)
+		require.Error(t, err)
+
+		verr := newValidationError(t, spec, ValidationStageTOML, err)
+		assert.Equal(t, ValidationStageTOML, verr.Stage)
+		assert.Equal(t, 2, verr.Line)
+		assert.NotZero(t, verr.Column)
+	})
+
+	t.Run("DOT syntax error in a multi-line observationSource", func(t *testing.T) {
+		spec := `type = "webhook"
+schemaVersion = 1
+observationSource = """
+    fetch [type=http method=GET url="http://example.com"];
+    fetch -> -> parse
+"""
+`
+		_, err := ValidateSpec(spec)
+		require.Error(t, err)
+
+		verr := newValidationError(t, spec, ValidationStageTOML, err)
+		assert.Equal(t, ValidationStagePipeline, verr.Stage)
+		assert.Equal(t, 5, verr.Line)
+	})
+
+	t.Run("DOT syntax error in a single-line observationSource", func(t *testing.T) {
+		spec := `type = "webhook"
+observationSource = "fetch -> -> parse"
+`
+		verr := newValidationError(t, spec, ValidationStageSpec, errors.New(`could not unmarshal DOT into a pipeline.Graph: 2:10: error: expected one of "{", subgraph or id; got: "->"`))
+		assert.Equal(t, ValidationStagePipeline, verr.Stage)
+		assert.Equal(t, 2, verr.Line)
+	})
+
+	t.Run("error referring to a value of the spec", func(t *testing.T) {
+		spec := `type = "offchainreporting2"
+transmitterID = "0x0000000000000000000000000000000000000001"
+observationSource = """
+    ds [type=bridge name="missing-bridge"];
+"""
+`
+		verr := newValidationError(t, spec, ValidationStageBridges, errors.New("not all bridges exist, asked for [missing-bridge], exists []"))
+		assert.Equal(t, ValidationStageBridges, verr.Stage)
+		assert.Equal(t, 4, verr.Line)
+		assert.Equal(t, 27, verr.Column)
+
+		verr = newValidationError(t, spec, ValidationStageJob, errors.New("no such transmitter key exists: 0x0000000000000000000000000000000000000001"))
+		assert.Equal(t, 2, verr.Line)
+	})
+
+	t.Run("unlocated error", func(t *testing.T) {
+		verr := newValidationError(t, `type = "webhook"`, ValidationStageSpec, errors.New("something went wrong"))
+		assert.Zero(t, verr.Line)
+		assert.Zero(t, verr.Column)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		spec := `type = "webhook"
+observationSource = """
+    a [type=bridge name="first-bridge"];
+    b [type=bridge name="second-bridge"];
+"""
+`
+		err := stderrors.Join(errors.New(`bridge "first-bridge" does not exist`), errors.New(`bridge "second-bridge" does not exist`))
+		verrs := NewValidationErrors(spec, spec, ValidationStageBridges, err)
+		require.Len(t, verrs, 2)
+		assert.Equal(t, 3, verrs[0].Line)
+		assert.Equal(t, 4, verrs[1].Line)
+	})
+
+	t.Run("error in an expanded fragment", func(t *testing.T) {
+		submitted := `type = "webhook"
+observationSource = """
+    start [type=memo value="x"];
+    price [type=fragment name=fetch_price params=<{"url": "https://chain.link"}>];
+    start -> price;
+"""
+externalJobID = "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"
+`
+		expanded, err := ExpandFragments(submitted, func(string) (string, error) {
+			return `
+				fetch [type=http method=GET url="{{ .url }}"];
+				parse [type=jsonparse path="price" data="$(fetch)" timeout="forever"];
+				fetch -> parse;
+			`, nil
+		})
+		require.NoError(t, err)
+		require.NotEqual(t, submitted, expanded)
+
+		// a value only found in the expanded source is mapped to the node including the fragment
+		verr := newValidationErrorOf(t, submitted, expanded, errors.New(`task price_fetch: bad method "GET"`))
+		assert.Equal(t, 4, verr.Line)
+		assert.Zero(t, verr.Column)
+		verr = newValidationErrorOf(t, submitted, expanded, errors.New(`task price: bad timeout "forever"`))
+		assert.Equal(t, 4, verr.Line)
+
+		// lines after the observationSource are shifted back
+		line := slices.IndexFunc(strings.Split(expanded, "\n"), func(l string) bool { return strings.HasPrefix(l, "externalJobID") }) + 1
+		require.Greater(t, line, 7)
+		verr = newValidationErrorOf(t, submitted, expanded, fmt.Errorf("(%d, 17): bad external job ID", line))
+		assert.Equal(t, 7, verr.Line)
+		assert.Equal(t, 17, verr.Column)
+	})
+}
+
+// newValidationErrorOf returns the single ValidationError of a spec stage error of expanded.
+func newValidationErrorOf(t *testing.T, submitted, expanded string, err error) ValidationError {
+	verrs := NewValidationErrors(submitted, expanded, ValidationStageSpec, err)
+	require.Len(t, verrs, 1)
+	return verrs[0]
+}
+
+func TestExpandFragments(t *testing.T) {
+	resolve := func(name string) (string, error) {
+		if name != "fetch_price" {
+			return "", errors.New("fragment not found")
+		}
+		return `fetch [type=http method=GET url="{{ .url }}"];`, nil
+	}
+
+	t.Run("expands the observationSource", func(t *testing.T) {
+		spec := `# the rest of the spec is kept as is
+type   = "webhook"
+schemaVersion = 1
+observationSource = """
+    price [type=fragment name=fetch_price params=<{"url": "https://chain.link"}>];
+"""
+`
+		expanded, err := ExpandFragments(spec, resolve)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(expanded, "# the rest of the spec is kept as is\ntype   = \"webhook\"\n"))
+
+		jobType, err := ValidateSpec(expanded)
+		require.NoError(t, err)
+		assert.Equal(t, Webhook, jobType)
+
+		var jb Job
+		tree, err := toml.Load(expanded)
+		require.NoError(t, err)
+		require.NoError(t, tree.Unmarshal(&jb))
+		require.Len(t, jb.Pipeline.Tasks, 1)
+		assert.Equal(t, "price", jb.Pipeline.Tasks[0].DotID())
+		assert.Equal(t, []string{"fetch_price"}, jb.Pipeline.Fragments())
+	})
+
+	t.Run("leaves specs without fragments unchanged", func(t *testing.T) {
+		spec := "type = \"webhook\"\nschemaVersion = 1\nobservationSource = \"ds [type=http];\"\n"
+		expanded, err := ExpandFragments(spec, resolve)
+		require.NoError(t, err)
+		assert.Equal(t, spec, expanded)
+	})
+
+	t.Run("unknown fragment", func(t *testing.T) {
+		spec := "type = \"webhook\"\nschemaVersion = 1\nobservationSource = \"ds [type=fragment name=missing];\"\n"
+		_, err := ExpandFragments(spec, resolve)
+		require.ErrorContains(t, err, "fragment not found")
+	})
+}
+
+func TestReplaceObservationSource(t *testing.T) {
+	spec := `# comments and formatting are kept
+type          = "webhook"
+schemaVersion = 1
+observationSource = """
+    ds [type=http url="https://chain.link"];
+"""
+name = "job"
+`
+	source := "ds [type=memo value=\"\\\"quoted\\\"\"];\nparse [type=jsonparse path=\"a\"];\nds -> parse;\n\"\"\"\n"
+	replaced, err := ReplaceObservationSource(spec, source)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(replaced, "# comments and formatting are kept\ntype          = \"webhook\"\n"))
+	assert.True(t, strings.HasSuffix(replaced, "\nname = \"job\"\n"))
+
+	tree, err := toml.Load(replaced)
+	require.NoError(t, err)
+	assert.Equal(t, source, tree.Get("observationSource"))
+	assert.Equal(t, "job", tree.Get("name"))
+
+	unchanged, err := ReplaceObservationSource(spec, "    ds [type=http url=\"https://chain.link\"];\n")
+	require.NoError(t, err)
+	assert.Equal(t, spec, unchanged)
+
+	_, err = ReplaceObservationSource("type = \"bootstrap\"", source)
+	require.Error(t, err)
+}
+
+func TestRedactSecrets(t *testing.T) {
+	spec := `type = "webhook"
+schemaVersion = 1
+signingSecret = "0123456789abcdef"
+observationSource = """
+    ds [type=http method=GET url="https://chain.link"];
+"""
+`
+	redacted := RedactSecrets(spec)
+	assert.NotContains(t, redacted, "0123456789abcdef")
+	assert.Equal(t, redacted, RedactSecrets(redacted))
+
+	tree, err := toml.Load(redacted)
+	require.NoError(t, err)
+	assert.Equal(t, RedactedSecret, tree.Get("signingSecret"))
+	assert.Contains(t, tree.Get("observationSource"), `url="https://chain.link"`)
+
+	t.Run("leaves specs without secrets unchanged", func(t *testing.T) {
+		spec := "type = \"webhook\"\nschemaVersion = 1\n"
+		assert.Equal(t, spec, RedactSecrets(spec))
+	})
+
+	t.Run("restores the secret of the current job", func(t *testing.T) {
+		current := Job{WebhookSpec: &WebhookSpec{SigningSecret: null.StringFrom("fedcba9876543210")}}
+		restored, err := RestoreSecrets(redacted, current)
+		require.NoError(t, err)
+
+		tree, err := toml.Load(restored)
+		require.NoError(t, err)
+		assert.Equal(t, "fedcba9876543210", tree.Get("signingSecret"))
+
+		_, err = RestoreSecrets(redacted, Job{WebhookSpec: &WebhookSpec{}})
+		require.ErrorContains(t, err, "signingSecret is redacted")
+	})
+}
@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,41 @@ func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
 	m.password = ""
+	m.wrappedDataKey = nil
+	m.kekProvider = ""
+	m.scryptParams = utils.FastScryptParams
+}
+
+// ExportedRawKeyRing returns the key ring saved in the database, including its legacy keys, along with the scrypt
+// parameters it is encrypted with.
+func (m *master) ExportedRawKeyRing(ctx context.Context, password string) (map[string][]string, utils.ScryptParams, error) {
+	ekr, err := m.orm.getEncryptedKeyRing(ctx)
+	if err != nil {
+		return nil, utils.ScryptParams{}, err
+	}
+	params, err := ekr.scryptParams()
+	if err != nil {
+		return nil, utils.ScryptParams{}, err
+	}
+	b, err := ekr.decryptRaw(password)
+	if err != nil {
+		return nil, utils.ScryptParams{}, err
+	}
+	raw := map[string][]string{}
+	return raw, params, json.Unmarshal(b, &raw)
+}
+
+// ExportedSaveRawKeyRing saves raw as the key ring, e.g. to add legacy keys to it.
+func (m *master) ExportedSaveRawKeyRing(ctx context.Context, raw map[string][]string, password string) error {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	ekr, err := encryptRaw(b, password, utils.FastScryptParams)
+	if err != nil {
+		return err
+	}
+	return m.orm.saveEncryptedKeyRing(ctx, &ekr)
 }
 
 func (m *master) SetPassword(pw string) {
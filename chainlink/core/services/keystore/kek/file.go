@@ -0,0 +1,95 @@
+package kek
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// fileKeySize is the size of the AES-256 key held by the key file.
+const fileKeySize = 32
+
+// wrapAAD binds the wrapped data keys to their purpose.
+var wrapAAD = []byte("chainlink keystore data key")
+
+// FileProvider wraps data keys with AES-256-GCM, using a hex encoded key read from a local file. The key file can
+// be generated with `openssl rand -hex 32`.
+type FileProvider struct {
+	aead cipher.AEAD
+}
+
+var _ Provider = (*FileProvider)(nil)
+
+// NewFileProvider reads the key from the file at path.
+func NewFileProvider(path string) (*FileProvider, error) {
+	if path == "" {
+		return nil, errors.New("file key encryption key provider requires a key file path")
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key encryption key file: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("key encryption key file must contain a hex encoded key: %w", err)
+	}
+	return newFileProvider(key)
+}
+
+func newFileProvider(key []byte) (*FileProvider, error) {
+	if len(key) != fileKeySize {
+		return nil, fmt.Errorf("key encryption key must be %d bytes, got %d", fileKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileProvider{aead: aead}, nil
+}
+
+// WriteKeyFile generates a new random key and writes it to path, which must not exist yet.
+func WriteKeyFile(path string) error {
+	key := make([]byte, fileKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("failed to generate key encryption key: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(hex.EncodeToString(key) + "\n")
+	return errors.Join(err, f.Close())
+}
+
+func (p *FileProvider) Name() string { return ProviderFile }
+
+// WrapKey returns the nonce followed by the sealed data key.
+func (p *FileProvider) WrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return p.aead.Seal(nonce, nonce, dataKey, wrapAAD), nil
+}
+
+func (p *FileProvider) UnwrapKey(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < p.aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	nonce, sealed := wrapped[:p.aead.NonceSize()], wrapped[p.aead.NonceSize():]
+	dataKey, err := p.aead.Open(nil, nonce, sealed, wrapAAD)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key, was it wrapped with another key: %w", err)
+	}
+	return dataKey, nil
+}
@@ -0,0 +1,51 @@
+// Package kek provides key encryption key (KEK) providers, which wrap the data key the keystore is encrypted with so
+// that the node can be unlocked by an external KMS or HSM instead of a plaintext password.
+package kek
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+const (
+	// ProviderFile wraps data keys with an AES-256 key read from a local file. It is the reference implementation,
+	// meant for development and testing rather than production use.
+	ProviderFile = "file"
+	// ProviderSocket delegates wrapping to an external plugin listening on a Unix socket, see SocketProvider.
+	ProviderSocket = "socket"
+
+	// DataKeySize is the size of the data keys generated for the keystore.
+	DataKeySize = 32
+)
+
+// Provider wraps and unwraps the data key of the keystore with a key encryption key it holds. The key encryption key
+// itself must never leave the provider.
+type Provider interface {
+	// Name identifies the provider, and is recorded alongside the wrapped data key.
+	Name() string
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// New returns the provider with the given name. path is the key file of the file provider or the socket of the
+// socket provider, and keyID is passed as is to the socket plugin to select its key.
+func New(name, path, keyID string) (Provider, error) {
+	switch name {
+	case ProviderFile:
+		return NewFileProvider(path)
+	case ProviderSocket:
+		return NewSocketProvider(path, keyID), nil
+	default:
+		return nil, fmt.Errorf("unknown key encryption key provider %q, expected %q or %q", name, ProviderFile, ProviderSocket)
+	}
+}
+
+// NewDataKey returns a random data key.
+func NewDataKey() ([]byte, error) {
+	dataKey := make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return dataKey, nil
+}
@@ -0,0 +1,96 @@
+package kek_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/kek"
+)
+
+func newFileProvider(t *testing.T) (*kek.FileProvider, string) {
+	path := filepath.Join(t.TempDir(), "kek")
+	require.NoError(t, kek.WriteKeyFile(path))
+	p, err := kek.NewFileProvider(path)
+	require.NoError(t, err)
+	return p, path
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p, path := newFileProvider(t)
+	require.Error(t, kek.WriteKeyFile(path), "existing key files must not be overwritten")
+
+	dataKey, err := kek.NewDataKey()
+	require.NoError(t, err)
+	wrapped, err := p.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(wrapped), string(dataKey))
+
+	unwrapped, err := p.UnwrapKey(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	other, _ := newFileProvider(t)
+	_, err = other.UnwrapKey(ctx, wrapped)
+	require.Error(t, err)
+
+	wrapped[len(wrapped)-1] ^= 1
+	_, err = p.UnwrapKey(ctx, wrapped)
+	require.Error(t, err)
+
+	badPath := filepath.Join(t.TempDir(), "bad")
+	require.NoError(t, os.WriteFile(badPath, []byte("abcd"), 0600))
+	_, err = kek.NewFileProvider(badPath)
+	require.ErrorContains(t, err, "must be 32 bytes")
+}
+
+func TestSocketProvider(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	fileProvider, _ := newFileProvider(t)
+	socketPath := filepath.Join(t.TempDir(), "kek.sock")
+	l, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() { done <- kek.Serve(ctx, l, fileProvider) }()
+	t.Cleanup(func() {
+		require.NoError(t, l.Close())
+		require.NoError(t, <-done)
+	})
+
+	p, err := kek.New(kek.ProviderSocket, socketPath, "test-key")
+	require.NoError(t, err)
+	assert.Equal(t, kek.ProviderSocket, p.Name())
+
+	dataKey, err := kek.NewDataKey()
+	require.NoError(t, err)
+	wrapped, err := p.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+
+	// the plugin holds the key, so the file provider can unwrap what it wrapped through the socket
+	unwrapped, err := fileProvider.UnwrapKey(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	unwrapped, err = p.UnwrapKey(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	_, err = p.UnwrapKey(ctx, []byte("garbage"))
+	require.ErrorContains(t, err, "key encryption key plugin failed to unwrap")
+
+	_, err = kek.NewSocketProvider(filepath.Join(t.TempDir(), "missing.sock"), "").WrapKey(ctx, dataKey)
+	require.ErrorContains(t, err, "failed to connect")
+
+	_, err = kek.New("vault", "", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,129 @@
+package kek
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	opWrap   = "wrap"
+	opUnwrap = "unwrap"
+
+	// socketTimeout bounds each request to the plugin when the context has no earlier deadline.
+	socketTimeout = 30 * time.Second
+)
+
+// SocketRequest is sent by SocketProvider to the plugin, as a single line of JSON per connection.
+type SocketRequest struct {
+	// Op is either "wrap" or "unwrap".
+	Op string `json:"op"`
+	// KeyID selects the key encryption key of the plugin, e.g. a KMS key ARN or a PKCS#11 key label.
+	KeyID string `json:"keyID,omitempty"`
+	// Data is the data key to wrap, or the wrapped data key to unwrap.
+	Data []byte `json:"data"`
+}
+
+// SocketResponse is the single line of JSON the plugin replies with. Error is set if the request failed.
+type SocketResponse struct {
+	Data  []byte `json:"data,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// SocketProvider delegates wrapping to an external plugin listening on a Unix socket, which typically fronts a cloud
+// KMS or a PKCS#11 HSM. See Serve for the server side of the protocol.
+type SocketProvider struct {
+	path  string
+	keyID string
+}
+
+var _ Provider = (*SocketProvider)(nil)
+
+// NewSocketProvider returns a provider sending its requests to the plugin listening on path.
+func NewSocketProvider(path, keyID string) *SocketProvider {
+	return &SocketProvider{path: path, keyID: keyID}
+}
+
+func (p *SocketProvider) Name() string { return ProviderSocket }
+
+func (p *SocketProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return p.do(ctx, opWrap, dataKey)
+}
+
+func (p *SocketProvider) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	return p.do(ctx, opUnwrap, wrapped)
+}
+
+func (p *SocketProvider) do(ctx context.Context, op string, data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, socketTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to key encryption key plugin: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = json.NewEncoder(conn).Encode(SocketRequest{Op: op, KeyID: p.keyID, Data: data}); err != nil {
+		return nil, fmt.Errorf("failed to send %s request to key encryption key plugin: %w", op, err)
+	}
+	var resp SocketResponse
+	if err = json.NewDecoder(bufio.NewReader(conn)).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("failed to read %s response of key encryption key plugin: %w", op, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("key encryption key plugin failed to %s data key: %s", op, resp.Error)
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("key encryption key plugin returned no data for %s", op)
+	}
+	return resp.Data, nil
+}
+
+// Serve answers the requests of SocketProvider received on l with provider, until l is closed. It is the server side
+// of the plugin protocol, which plugins written in Go can reuse and which exposes the file provider for testing.
+func Serve(ctx context.Context, l net.Listener, provider Provider) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go serveConn(ctx, conn, provider)
+	}
+}
+
+func serveConn(ctx context.Context, conn net.Conn, provider Provider) {
+	defer conn.Close()
+	var req SocketRequest
+	var resp SocketResponse
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&req); err != nil {
+		resp.Error = fmt.Sprintf("invalid request: %v", err)
+	} else {
+		var err error
+		switch req.Op {
+		case opWrap:
+			resp.Data, err = provider.WrapKey(ctx, req.Data)
+		case opUnwrap:
+			resp.Data, err = provider.UnwrapKey(ctx, req.Data)
+		default:
+			err = fmt.Errorf("unknown op %q", req.Op)
+		}
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	}
+	_ = json.NewEncoder(conn).Encode(resp)
+}
@@ -1,16 +1,21 @@
 package keystore
 
 import (
+	"bytes"
 	"context"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"reflect"
 	"sync"
 
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/kek"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/aptoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
@@ -30,9 +35,20 @@ import (
 )
 
 var (
-	ErrLocked      = errors.New("Keystore is locked")
-	ErrKeyNotFound = errors.New("Key not found")
-	ErrKeyExists   = errors.New("Key already exists")
+	ErrLocked          = errors.New("Keystore is locked")
+	ErrKeyNotFound     = errors.New("Key not found")
+	ErrKeyExists       = errors.New("Key already exists")
+	ErrInvalidPassword = errors.New("Invalid keystore password")
+	// ErrNoPassword is returned when rotating the password of a keystore unlocked with a key encryption key provider.
+	ErrNoPassword          = errors.New("Keystore is unlocked with a key encryption key provider and has no password")
+	ErrInvalidScryptParams = errors.New("Invalid scrypt parameters")
+)
+
+// The maximum scrypt parameters accepted when rotating the password. Each unlock of the keystore needs 128 * 8 * N
+// bytes of memory, 1GiB at the maximum N, and P times the work.
+const (
+	maxScryptN = 1 << 20
+	maxScryptP = 16
 )
 
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
@@ -56,6 +72,8 @@ type Master interface {
 	Workflow() Workflow
 	DKGRecipient() DKGRecipient
 	Unlock(ctx context.Context, password string) error
+	UnlockWithKEK(ctx context.Context, provider kek.Provider, password string) error
+	RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams, rotated func()) error
 	IsEmpty(ctx context.Context) (bool, error)
 }
 type master struct {
@@ -192,6 +210,10 @@ type keyManager struct {
 	lock         *sync.RWMutex
 	password     string
 	announce     func(Key)
+	// wrappedDataKey and kekProvider are set when the keystore is unlocked with a key encryption key provider, in
+	// which case password is derived from the data key.
+	wrappedDataKey []byte
+	kekProvider    string
 }
 
 func (km *keyManager) IsEmpty(ctx context.Context) (bool, error) {
@@ -212,11 +234,15 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to get encrypted key ring")
 	}
+	if len(ekr.WrappedDataKey) > 0 {
+		return errors.Errorf("key ring is encrypted with a data key wrapped by the %s key encryption key provider, which must be configured to unlock it", ekr.KEKProvider.String)
+	}
 	kr, err := ekr.Decrypt(password)
 	if err != nil {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
 	km.keyRing = kr
+	km.adoptScryptParams(ekr)
 
 	ks, err := km.keystateORM.loadKeyStates(ctx)
 	if err != nil {
@@ -228,12 +254,201 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+// UnlockWithKEK unlocks the keystore with a data key wrapped by provider, so that the node needs no keystore password.
+// A new keystore gets a new data key. A keystore encrypted with a password is migrated to a new data key, which
+// requires its password this one time.
+func (km *keyManager) UnlockWithKEK(ctx context.Context, provider kek.Provider, password string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	// DEV: allow UnlockWithKEK() to be idempotent, like Unlock()
+	if km.password != "" {
+		if km.kekProvider != provider.Name() {
+			return errors.New("attempting to unlock keystore again with a different key encryption key provider")
+		}
+		return nil
+	}
+	ekr, err := km.orm.getEncryptedKeyRing(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get encrypted key ring")
+	}
+
+	var kr *keyRing
+	var dataKey []byte
+	if len(ekr.WrappedDataKey) > 0 {
+		dataKey, err = provider.UnwrapKey(ctx, ekr.WrappedDataKey)
+		if err != nil {
+			return errors.Wrapf(err, "unable to unwrap data key wrapped by the %s key encryption key provider", ekr.KEKProvider.String)
+		}
+		kr, err = ekr.Decrypt(dataKeyPassword(dataKey))
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt encrypted key ring")
+		}
+		km.adoptScryptParams(ekr)
+	} else {
+		if len(ekr.EncryptedKeys) > 0 && password == "" {
+			return errors.New("key ring is encrypted with a password, which is required once to migrate it to the key encryption key provider")
+		}
+		kr, err = ekr.Decrypt(password)
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt encrypted key ring")
+		}
+		km.adoptScryptParams(ekr)
+		dataKey, err = kek.NewDataKey()
+		if err != nil {
+			return err
+		}
+		plaintext, err2 := kr.marshal()
+		if err2 != nil {
+			return errors.Wrap(err2, "unable to marshal keyRing")
+		}
+		ekr, err = encryptRaw(plaintext, dataKeyPassword(dataKey), km.scryptParams)
+		if err != nil {
+			return errors.Wrap(err, "unable to encrypt keyRing")
+		}
+		ekr.WrappedDataKey, err = provider.WrapKey(ctx, dataKey)
+		if err != nil {
+			return errors.Wrapf(err, "unable to wrap data key with the %s key encryption key provider", provider.Name())
+		}
+		// the password encrypted key ring is overwritten, so the data key must be recoverable before it is saved
+		unwrapped, err2 := provider.UnwrapKey(ctx, ekr.WrappedDataKey)
+		if err2 != nil {
+			return errors.Wrapf(err2, "unable to unwrap the data key just wrapped by the %s key encryption key provider", provider.Name())
+		}
+		if subtle.ConstantTimeCompare(unwrapped, dataKey) != 1 {
+			return errors.Errorf("the %s key encryption key provider unwrapped a different data key", provider.Name())
+		}
+		ekr.KEKProvider = null.StringFrom(provider.Name())
+		// the data key must be persisted before anything is encrypted with it
+		err = km.orm.saveEncryptedKeyRing(ctx, &ekr, func(tx sqlutil.DataSource) error {
+			return verifySavedKeyRing(ctx, tx, dataKeyPassword(dataKey), plaintext)
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to save migrated key ring")
+		}
+	}
+	km.keyRing = kr
+
+	ks, err := km.keystateORM.loadKeyStates(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to load key states")
+	}
+	km.keyStates = ks
+
+	km.password = dataKeyPassword(dataKey)
+	km.wrappedDataKey = ekr.WrappedDataKey
+	km.kekProvider = provider.Name()
+	return nil
+}
+
+// RotatePassword re-encrypts the whole key ring with newPassword and scryptParams, which are used for every later save.
+// Zero scrypt parameters keep their current value. The saved key ring is read back and decrypted within the
+// transaction saving it, so that it is only committed once verified. rotated, if not nil, is called once the rotation
+// is committed and before any other keystore operation, e.g. to update the password in the config.
+func (km *keyManager) RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams, rotated func()) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if scryptParams.N == 0 {
+		scryptParams.N = km.scryptParams.N
+	}
+	if scryptParams.P == 0 {
+		scryptParams.P = km.scryptParams.P
+	}
+	if err := validateScryptParams(scryptParams); err != nil {
+		return err
+	}
+	if km.kekProvider != "" {
+		return ErrNoPassword
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrInvalidPassword
+	}
+
+	// the legacy keys the node no longer supports are kept along with the others
+	plaintext, err := km.keyRing.marshal()
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal keyRing")
+	}
+	ekr, err := encryptRaw(plaintext, newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	err = km.orm.saveEncryptedKeyRing(ctx, &ekr, func(tx sqlutil.DataSource) error {
+		return verifySavedKeyRing(ctx, tx, newPassword, plaintext)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to save rotated key ring")
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	if rotated != nil {
+		rotated()
+	}
+	return nil
+}
+
+// verifySavedKeyRing reads the key ring back within the transaction saving it, and asserts it decrypts with password
+// to plaintext, so that a key ring is only committed once it is known to be recoverable.
+func verifySavedKeyRing(ctx context.Context, tx sqlutil.DataSource, password string, plaintext []byte) error {
+	saved, err := ksORM{ds: tx}.getEncryptedKeyRing(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to read saved key ring")
+	}
+	decrypted, err := saved.decryptRaw(password)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt saved key ring")
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		return errors.New("saved key ring does not match the key ring")
+	}
+	return nil
+}
+
+func validateScryptParams(params utils.ScryptParams) error {
+	if params.N <= 1 || params.N&(params.N-1) != 0 {
+		return errors.Wrapf(ErrInvalidScryptParams, "N must be a power of 2 greater than 1, got %d", params.N)
+	}
+	if params.N > maxScryptN {
+		return errors.Wrapf(ErrInvalidScryptParams, "N must be at most %d, got %d", maxScryptN, params.N)
+	}
+	if params.P < 1 || params.P > maxScryptP {
+		return errors.Wrapf(ErrInvalidScryptParams, "P must be between 1 and %d, got %d", maxScryptP, params.P)
+	}
+	return nil
+}
+
+// adoptScryptParams keeps the scrypt parameters the key ring is encrypted with if they cost more than the configured
+// ones, so that a cost raised by RotatePassword is not lowered again by the next save after a restart.
+func (km *keyManager) adoptScryptParams(ekr encryptedKeyRing) {
+	if len(ekr.EncryptedKeys) == 0 {
+		return
+	}
+	params, err := ekr.scryptParams()
+	if err != nil {
+		return
+	}
+	if params.N*params.P > km.scryptParams.N*km.scryptParams.P {
+		km.scryptParams = params
+	}
+}
+
+// dataKeyPassword returns the password the key ring is encrypted with, when unlocked with a data key.
+func dataKeyPassword(dataKey []byte) string {
+	return hex.EncodeToString(dataKey)
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
 	if err != nil {
 		return errors.Wrap(err, "unable to encrypt keyRing")
 	}
+	if len(km.wrappedDataKey) > 0 {
+		ekb.WrappedDataKey = km.wrappedDataKey
+		ekb.KEKProvider = null.StringFrom(km.kekProvider)
+	}
 	return km.orm.saveEncryptedKeyRing(ctx, &ekb, callbacks...)
 }
 
@@ -1,8 +1,11 @@
 package keystore_test
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -10,6 +13,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/internal"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/kek"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -65,6 +70,145 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 	})
 }
 
+func TestMasterKeystore_UnlockWithKEK(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const tableName = "encrypted_key_rings"
+	reset := func() {
+		keyStore.ResetXXXTestOnly()
+		_, err := db.Exec("DELETE FROM " + tableName)
+		require.NoError(t, err)
+	}
+	newProvider := func(t *testing.T) kek.Provider {
+		path := filepath.Join(t.TempDir(), "kek")
+		require.NoError(t, kek.WriteKeyFile(path))
+		p, err := kek.NewFileProvider(path)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("wraps a new data key for a new keystore", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		provider := newProvider(t)
+		require.NoError(t, keyStore.UnlockWithKEK(ctx, provider, ""))
+		require.NoError(t, keyStore.UnlockWithKEK(ctx, provider, ""))
+		key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+		var wrapped []byte
+		require.NoError(t, db.Get(&wrapped, "SELECT wrapped_data_key FROM "+tableName))
+		require.NotEmpty(t, wrapped)
+
+		keyStore.ResetXXXTestOnly()
+		require.ErrorContains(t, keyStore.Unlock(ctx, cltest.Password), "file key encryption key provider")
+		require.Error(t, keyStore.UnlockWithKEK(ctx, newProvider(t), ""))
+		require.NoError(t, keyStore.UnlockWithKEK(ctx, provider, ""))
+		found, err := keyStore.Eth().Get(ctx, key.Address.Hex())
+		require.NoError(t, err)
+		requireEqualKeys(t, key, found)
+	})
+
+	t.Run("migrates a keystore encrypted with a password", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+		keyStore.ResetXXXTestOnly()
+
+		provider := newProvider(t)
+		require.ErrorContains(t, keyStore.UnlockWithKEK(ctx, provider, ""), "required once to migrate")
+		require.Error(t, keyStore.UnlockWithKEK(ctx, provider, "wrong password"))
+		require.NoError(t, keyStore.UnlockWithKEK(ctx, provider, cltest.Password))
+
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.UnlockWithKEK(ctx, provider, ""))
+		found, err := keyStore.Eth().Get(ctx, key.Address.Hex())
+		require.NoError(t, err)
+		requireEqualKeys(t, key, found)
+	})
+
+	t.Run("keeps the password encrypted keystore if the data key cannot be unwrapped", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+		keyStore.ResetXXXTestOnly()
+
+		provider := &badUnwrapProvider{Provider: newProvider(t)}
+		require.ErrorContains(t, keyStore.UnlockWithKEK(ctx, provider, cltest.Password), "unwrapped a different data key")
+
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		found, err := keyStore.Eth().Get(ctx, key.Address.Hex())
+		require.NoError(t, err)
+		requireEqualKeys(t, key, found)
+	})
+}
+
+// badUnwrapProvider unwraps every data key to a key of the wrong length.
+type badUnwrapProvider struct {
+	kek.Provider
+}
+
+func (p *badUnwrapProvider) UnwrapKey(context.Context, []byte) ([]byte, error) {
+	return []byte("short"), nil
+}
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const newPassword = "16charlengthp4SsW0rD1!@#_new"
+	newParams := utils.ScryptParams{N: 4, P: 1}
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, newParams, nil), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	// add a legacy key, which is no longer supported but must be kept
+	raw, _, err := keyStore.ExportedRawKeyRing(ctx, cltest.Password)
+	require.NoError(t, err)
+	raw["Legacy"] = []string{"legacy-key"}
+	require.NoError(t, keyStore.ExportedSaveRawKeyRing(ctx, raw, cltest.Password))
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, "wrong password", newPassword, newParams, nil), keystore.ErrInvalidPassword)
+	require.ErrorContains(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{N: 3, P: 1}, nil), "N must be a power of 2")
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{N: 1 << 21, P: 1}, nil), keystore.ErrInvalidScryptParams)
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{N: 4, P: 17}, nil), keystore.ErrInvalidScryptParams)
+	var rotated bool
+	require.NoError(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, newParams, func() { rotated = true }))
+	assert.True(t, rotated)
+
+	raw, params, err := keyStore.ExportedRawKeyRing(ctx, newPassword)
+	require.NoError(t, err)
+	assert.Equal(t, newParams, params)
+	assert.Equal(t, []string{"legacy-key"}, raw["Legacy"])
+	_, _, err = keyStore.ExportedRawKeyRing(ctx, cltest.Password)
+	require.Error(t, err)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+	found, err := keyStore.Eth().Get(ctx, key.Address.Hex())
+	require.NoError(t, err)
+	requireEqualKeys(t, key, found)
+
+	// the raised scrypt cost is kept by later saves, even though the keystore is configured with a lower one
+	require.NoError(t, keyStore.ExportedSave(ctx))
+	_, params, err = keyStore.ExportedRawKeyRing(ctx, newPassword)
+	require.NoError(t, err)
+	assert.Equal(t, newParams, params)
+}
+
 func requireEqualKeys(t *testing.T, a, b interface {
 	ID() string
 	Raw() internal.Raw
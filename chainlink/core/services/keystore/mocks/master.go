@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	kek "github.com/smartcontractkit/chainlink/v2/core/services/keystore/kek"
+
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -454,6 +458,56 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// RotatePassword provides a mock function with given fields: ctx, oldPassword, newPassword, scryptParams, rotated
+func (_m *Master) RotatePassword(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams, rotated func()) error {
+	ret := _m.Called(ctx, oldPassword, newPassword, scryptParams, rotated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams, func()) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword, scryptParams, rotated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+//   - rotated func()
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, oldPassword interface{}, newPassword interface{}, scryptParams interface{}, rotated interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword", ctx, oldPassword, newPassword, scryptParams, rotated)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams, rotated func())) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams), args[4].(func()))
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams, func()) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -736,6 +790,54 @@ func (_c *Master_Unlock_Call) RunAndReturn(run func(context.Context, string) err
 	return _c
 }
 
+// UnlockWithKEK provides a mock function with given fields: ctx, provider, password
+func (_m *Master) UnlockWithKEK(ctx context.Context, provider kek.Provider, password string) error {
+	ret := _m.Called(ctx, provider, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockWithKEK")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, kek.Provider, string) error); ok {
+		r0 = rf(ctx, provider, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_UnlockWithKEK_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockWithKEK'
+type Master_UnlockWithKEK_Call struct {
+	*mock.Call
+}
+
+// UnlockWithKEK is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider kek.Provider
+//   - password string
+func (_e *Master_Expecter) UnlockWithKEK(ctx interface{}, provider interface{}, password interface{}) *Master_UnlockWithKEK_Call {
+	return &Master_UnlockWithKEK_Call{Call: _e.mock.On("UnlockWithKEK", ctx, provider, password)}
+}
+
+func (_c *Master_UnlockWithKEK_Call) Run(run func(ctx context.Context, provider kek.Provider, password string)) *Master_UnlockWithKEK_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(kek.Provider), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Master_UnlockWithKEK_Call) Return(_a0 error) *Master_UnlockWithKEK_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_UnlockWithKEK_Call) RunAndReturn(run func(context.Context, kek.Provider, string) error) *Master_UnlockWithKEK_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VRF provides a mock function with no fields
 func (_m *Master) VRF() keystore.VRF {
 	ret := _m.Called()
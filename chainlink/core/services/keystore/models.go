@@ -9,6 +9,7 @@ import (
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
@@ -34,18 +35,17 @@ import (
 type encryptedKeyRing struct {
 	UpdatedAt     time.Time
 	EncryptedKeys []byte
+	// WrappedDataKey is set when EncryptedKeys is encrypted with a data key wrapped by the KEKProvider, rather than
+	// with the keystore password.
+	WrappedDataKey []byte
+	KEKProvider    null.String `db:"kek_provider"`
 }
 
 func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if len(ekr.EncryptedKeys) == 0 {
 		return newKeyRing(), nil
 	}
-	var cryptoJSON gethkeystore.CryptoJSON
-	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
-	if err != nil {
-		return nil, err
-	}
-	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+	marshalledRawKeyRingJson, err := ekr.decryptRaw(password)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +68,30 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	return ring, nil
 }
 
+// decryptRaw returns the marshalled key ring, including the legacy keys.
+func (ekr encryptedKeyRing) decryptRaw(password string) ([]byte, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
+	if err != nil {
+		return nil, err
+	}
+	return gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+}
+
+// scryptParams returns the scrypt parameters the key ring was encrypted with.
+func (ekr encryptedKeyRing) scryptParams() (utils.ScryptParams, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON); err != nil {
+		return utils.ScryptParams{}, err
+	}
+	n, okN := cryptoJSON.KDFParams["n"].(float64)
+	p, okP := cryptoJSON.KDFParams["p"].(float64)
+	if cryptoJSON.KDF != "scrypt" || !okN || !okP {
+		return utils.ScryptParams{}, errors.Errorf("key ring is not encrypted with scrypt parameters, got kdf %q", cryptoJSON.KDF)
+	}
+	return utils.ScryptParams{N: int(n), P: int(p)}, nil
+}
+
 type keyStates struct {
 	// Key ID => chain ID => state
 	KeyIDChainID map[string]map[string]*ethkey.State
@@ -194,16 +218,24 @@ func newKeyRing() *keyRing {
 }
 
 func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
-	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	marshalledRawKeyRingJson, err := kr.marshal()
 	if err != nil {
 		return ekr, err
 	}
+	return encryptRaw(marshalledRawKeyRingJson, password, scryptParams)
+}
 
-	marshalledRawKeyRingJson, err = kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+// marshal returns the marshalled key ring, including the legacy keys the node no longer supports so that they are
+// not lost.
+func (kr *keyRing) marshal() ([]byte, error) {
+	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
 	if err != nil {
-		return encryptedKeyRing{}, err
+		return nil, err
 	}
+	return kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+}
 
+func encryptRaw(marshalledRawKeyRingJson []byte, password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
 	cryptoJSON, err := gethkeystore.EncryptDataV3(
 		marshalledRawKeyRingJson,
 		[]byte(adulteratedPassword(password)),
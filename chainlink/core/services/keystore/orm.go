@@ -33,8 +33,8 @@ func (orm ksORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing,
 	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
 		_, err := tx.ExecContext(ctx, `
 		UPDATE encrypted_key_rings
-		SET encrypted_keys = $1
-	`, kr.EncryptedKeys)
+		SET encrypted_keys = $1, wrapped_data_key = $2, kek_provider = $3
+	`, kr.EncryptedKeys, kr.WrappedDataKey, kr.KEKProvider)
 		if err != nil {
 			return errors.Wrap(err, "while saving keyring")
 		}
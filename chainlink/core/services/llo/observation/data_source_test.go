@@ -534,6 +534,7 @@ func BenchmarkObserve(b *testing.B) {
 	runner := pipeline.NewRunner(
 		nil,
 		bridgesORM,
+		nil,
 		&mockPipelineConfig{},
 		&mockBridgeConfig{},
 		nil,
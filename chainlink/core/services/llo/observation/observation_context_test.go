@@ -169,7 +169,10 @@ func TestObservationContext_Observe_concurrencyStressTest(t *testing.T) {
 
 type mockPipelineConfig struct{}
 
-func (m *mockPipelineConfig) DefaultHTTPLimit() int64 { return 10000 }
+func (m *mockPipelineConfig) CircuitBreakerEnabled() bool               { return false }
+func (m *mockPipelineConfig) CircuitBreakerFailureThreshold() uint32    { return 0 }
+func (m *mockPipelineConfig) CircuitBreakerResetTimeout() time.Duration { return 0 }
+func (m *mockPipelineConfig) DefaultHTTPLimit() int64                   { return 10000 }
 func (m *mockPipelineConfig) DefaultHTTPTimeout() commonconfig.Duration {
 	return *commonconfig.MustNewDuration(1 * time.Hour)
 }
@@ -229,6 +232,7 @@ func TestObservationContext_Observe_integrationRealPipeline(t *testing.T) {
 	runner := pipeline.NewRunner(
 		nil,
 		bridgesORM,
+		nil,
 		&mockPipelineConfig{},
 		&mockBridgeConfig{},
 		nil,
@@ -311,6 +315,7 @@ func BenchmarkObservationContext_Observe_integrationRealPipeline_concurrencyStre
 	runner := pipeline.NewRunner(
 		nil,
 		bridgesORM,
+		nil,
 		&mockPipelineConfig{},
 		&mockBridgeConfig{},
 		nil,
@@ -0,0 +1,106 @@
+package logtrigger
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type Config interface {
+	Feature() config.Feature
+}
+
+// Delegate creates log trigger jobs, which start a pipeline run for every log matching their filter.
+type Delegate struct {
+	cfg            Config
+	ds             sqlutil.DataSource
+	pipelineRunner pipeline.Runner
+	legacyChains   legacyevm.LegacyChainContainer
+	lggr           logger.Logger
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	cfg Config,
+	ds sqlutil.DataSource,
+	pipelineRunner pipeline.Runner,
+	legacyChains legacyevm.LegacyChainContainer,
+	lggr logger.Logger,
+) *Delegate {
+	return &Delegate{
+		cfg:            cfg,
+		ds:             ds,
+		pipelineRunner: pipelineRunner,
+		legacyChains:   legacyChains,
+		lggr:           lggr.Named("LogTrigger"),
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.LogTrigger
+}
+
+func (d *Delegate) BeforeJobCreated(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// OnDeleteJob unregisters the log poller filter of the job, which is kept across restarts otherwise.
+func (d *Delegate) OnDeleteJob(ctx context.Context, jb job.Job) error {
+	if jb.LogTriggerSpec == nil {
+		return nil
+	}
+	chain, err := d.chain(jb.LogTriggerSpec)
+	if err != nil {
+		d.lggr.Errorw("Failed to unregister log poller filter of deleted job", "jobID", jb.ID, "err", err)
+		return nil
+	}
+	return chain.LogPoller().UnregisterFilter(ctx, filterName(jb.ID))
+}
+
+// ServicesForSpec returns the log listener of the job.
+func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.ServiceCtx, error) {
+	if jb.LogTriggerSpec == nil {
+		return nil, errors.Errorf("logtrigger.Delegate expects a *job.LogTriggerSpec to be present, got %v", jb)
+	}
+	if !d.cfg.Feature().LogPoller() {
+		return nil, errors.New("log poller must be enabled to run logtrigger jobs")
+	}
+	chain, err := d.chain(jb.LogTriggerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := newListener(jb, chain.LogPoller(), d.pipelineRunner, d.ds, d.lggr)
+	if err != nil {
+		return nil, err
+	}
+	return []job.ServiceCtx{l}, nil
+}
+
+func (d *Delegate) chain(spec *job.LogTriggerSpec) (legacyevm.Chain, error) {
+	chainService, err := d.legacyChains.Get(spec.EVMChainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("getting chain ID %s: %w", spec.EVMChainID, err)
+	}
+	chain, ok := chainService.(legacyevm.Chain)
+	if !ok {
+		return nil, fmt.Errorf("logtrigger is not available in LOOP Plugin mode: %w", stderrors.ErrUnsupported)
+	}
+	return chain, nil
+}
+
+func filterName(jobID int32) string {
+	return logpoller.FilterName("LogTrigger", jobID)
+}
@@ -0,0 +1,30 @@
+package logtrigger
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func NewTestListener(jb job.Job, lp logpoller.LogPoller, pipelineRunner pipeline.Runner, ds sqlutil.DataSource, lggr logger.Logger) (*listener, error) {
+	return newListener(jb, lp, pipelineRunner, ds, lggr)
+}
+
+func (l *listener) ExportedPoll(ctx context.Context) {
+	l.poll(ctx)
+}
+
+func (l *listener) ExportedEventID() common.Hash {
+	return l.event.ID
+}
+
+func (l *listener) ExportedCursor() (block, logIndex int64) {
+	return l.cursorBlock, l.cursorLogIndex
+}
@@ -0,0 +1,274 @@
+package logtrigger
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// maxBlocksPerPoll bounds the block range queried at once, so that catching up on a long backlog does not
+// load all of its logs in memory.
+const maxBlocksPerPoll = 1000
+
+var _ job.ServiceCtx = (*listener)(nil)
+
+// listener polls the log poller for logs matching the job's filter and starts a pipeline run for each of
+// them, in block and log index order. Its position is persisted in the spec's cursor, in the same
+// transaction as the run of each log, so that logs are processed once across restarts.
+type listener struct {
+	services.StateMachine
+	job            job.Job
+	event          abi.Event
+	topics         [3][]common.Hash
+	logPoller      logpoller.LogPoller
+	pipelineRunner pipeline.Runner
+	ds             sqlutil.DataSource
+	lggr           logger.Logger
+	chStop         services.StopChan
+	wg             sync.WaitGroup
+
+	// cursorBlock and cursorLogIndex locate the next log to process, see job.LogTriggerSpec.
+	cursorBlock    int64
+	cursorLogIndex int64
+	hasCursor      bool
+	needsReplay    bool
+}
+
+func newListener(jb job.Job, lp logpoller.LogPoller, pipelineRunner pipeline.Runner, ds sqlutil.DataSource, lggr logger.Logger) (*listener, error) {
+	event, topics, err := parseEvent(*jb.LogTriggerSpec)
+	if err != nil {
+		return nil, err
+	}
+	spec := jb.LogTriggerSpec
+	l := &listener{
+		job:            jb,
+		event:          event,
+		topics:         topics,
+		logPoller:      lp,
+		pipelineRunner: pipelineRunner,
+		ds:             ds,
+		lggr:           lggr.With("jobID", jb.ID, "contractAddress", spec.ContractAddress, "event", event.Sig),
+		chStop:         make(chan struct{}),
+		cursorBlock:    spec.CursorBlock.Int64,
+		cursorLogIndex: spec.CursorLogIndex,
+		hasCursor:      spec.CursorBlock.Valid,
+	}
+	if !l.hasCursor && spec.FromBlock > 0 {
+		l.cursorBlock, l.cursorLogIndex, l.hasCursor = spec.FromBlock, 0, true
+		l.needsReplay = true
+	}
+	return l, nil
+}
+
+func (l *listener) Start(ctx context.Context) error {
+	return l.StartOnce("LogTriggerListener", func() error {
+		spec := l.job.LogTriggerSpec
+		err := l.logPoller.RegisterFilter(ctx, logpoller.Filter{
+			Name:      filterName(l.job.ID),
+			Addresses: evmtypes.AddressArray{spec.ContractAddress.Address()},
+			EventSigs: evmtypes.HashArray{l.event.ID},
+			Topic2:    l.topics[0],
+			Topic3:    l.topics[1],
+			Topic4:    l.topics[2],
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to register log poller filter")
+		}
+
+		l.wg.Add(1)
+		go l.run()
+		return nil
+	})
+}
+
+func (l *listener) Close() error {
+	return l.StopOnce("LogTriggerListener", func() error {
+		close(l.chStop)
+		l.wg.Wait()
+		return nil
+	})
+}
+
+func (l *listener) run() {
+	defer l.wg.Done()
+	ctx, cancel := l.chStop.NewCtx()
+	defer cancel()
+
+	ticker := time.NewTicker(l.job.LogTriggerSpec.PollPeriod)
+	defer ticker.Stop()
+	for {
+		l.poll(ctx)
+		select {
+		case <-l.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll processes the confirmed logs after the cursor, up to maxBlocksPerPoll blocks at a time.
+func (l *listener) poll(ctx context.Context) {
+	spec := l.job.LogTriggerSpec
+	if l.needsReplay {
+		// The filter was just registered, so the logs before it must be fetched before they can be queried.
+		if err := l.logPoller.Replay(ctx, spec.FromBlock); err != nil {
+			l.lggr.Errorw("Failed to replay logs from fromBlock", "fromBlock", spec.FromBlock, "err", err)
+			return
+		}
+		l.needsReplay = false
+	}
+
+	latest, err := l.logPoller.LatestBlock(ctx)
+	if err != nil {
+		l.lggr.Errorw("Failed to get latest block", "err", err)
+		return
+	}
+	end := latest.BlockNumber - int64(spec.MinConfirmations)
+	if !l.hasCursor {
+		// Only logs emitted after the job started are processed.
+		if err = l.saveCursor(ctx, l.ds, end+1, 0); err != nil {
+			l.lggr.Errorw("Failed to save cursor", "err", err)
+			return
+		}
+		l.moveCursor(end+1, 0)
+		return
+	}
+	if end < l.cursorBlock {
+		return
+	}
+	end = min(end, l.cursorBlock+maxBlocksPerPoll-1)
+
+	logs, err := l.logPoller.LogsWithSigs(ctx, l.cursorBlock, end, []common.Hash{l.event.ID}, spec.ContractAddress.Address())
+	if err != nil {
+		l.lggr.Errorw("Failed to query logs", "fromBlock", l.cursorBlock, "toBlock", end, "err", err)
+		return
+	}
+	slices.SortFunc(logs, func(a, b logpoller.Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return cmp.Compare(a.BlockNumber, b.BlockNumber)
+		}
+		return cmp.Compare(a.LogIndex, b.LogIndex)
+	})
+	for _, lg := range logs {
+		if lg.BlockNumber == l.cursorBlock && lg.LogIndex < l.cursorLogIndex {
+			continue
+		}
+		if !l.matchesTopics(lg) {
+			continue
+		}
+		if err = l.runPipeline(ctx, lg); err != nil {
+			// The cursor is left on this log, so that it is retried on the next poll.
+			l.lggr.Errorw("Failed to run pipeline for log", "blockNumber", lg.BlockNumber, "logIndex", lg.LogIndex, "err", err)
+			return
+		}
+	}
+	if err = l.saveCursor(ctx, l.ds, end+1, 0); err != nil {
+		l.lggr.Errorw("Failed to save cursor", "err", err)
+		return
+	}
+	l.moveCursor(end+1, 0)
+}
+
+func (l *listener) matchesTopics(lg logpoller.Log) bool {
+	topics := lg.GetTopics()
+	for i, values := range l.topics {
+		if len(values) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(values, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *listener) runPipeline(ctx context.Context, lg logpoller.Log) error {
+	spec := l.job.LogTriggerSpec
+	decoded, err := l.decode(lg)
+	if err != nil {
+		// A log which does not match the ABI will never be decoded, so it is skipped rather than retried.
+		l.lggr.Errorw("Skipping log which does not match eventABI", "blockNumber", lg.BlockNumber, "logIndex", lg.LogIndex, "err", err)
+		if err = l.saveCursor(ctx, l.ds, lg.BlockNumber, lg.LogIndex+1); err != nil {
+			return err
+		}
+		l.moveCursor(lg.BlockNumber, lg.LogIndex+1)
+		return nil
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]any{
+		"jobSpec": map[string]any{
+			"databaseID":    l.job.ID,
+			"externalJobID": l.job.ExternalJobID,
+			"name":          l.job.Name.ValueOrZero(),
+			"evmChainID":    spec.EVMChainID.String(),
+		},
+		"jobRun": map[string]any{
+			"meta":           map[string]any{},
+			"logBlockHash":   lg.BlockHash,
+			"logBlockNumber": lg.BlockNumber,
+			"logTxHash":      lg.TxHash,
+			"logIndex":       lg.LogIndex,
+			"logAddress":     lg.Address,
+			"logTopics":      lg.GetTopics(),
+			"logData":        lg.Data,
+		},
+		"log": decoded,
+	})
+	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
+	if _, err = l.pipelineRunner.Run(ctx, run, true, func(tx sqlutil.DataSource) error {
+		return l.saveCursor(ctx, tx, lg.BlockNumber, lg.LogIndex+1)
+	}); err != nil {
+		return err
+	}
+	// The run and the cursor are committed together, so the cursor only moves once both are saved.
+	l.moveCursor(lg.BlockNumber, lg.LogIndex+1)
+	return nil
+}
+
+// decode returns the arguments of the event, indexed and not, by name.
+func (l *listener) decode(lg logpoller.Log) (map[string]any, error) {
+	decoded := make(map[string]any)
+	if err := l.event.Inputs.NonIndexed().UnpackIntoMap(decoded, lg.Data); err != nil {
+		return nil, err
+	}
+	var indexed abi.Arguments
+	for _, arg := range l.event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	topics := lg.GetTopics()
+	if len(topics) != len(indexed)+1 {
+		return nil, errors.Errorf("expected %d topics, got %d", len(indexed)+1, len(topics))
+	}
+	if err := abi.ParseTopicsIntoMap(decoded, indexed, topics[1:]); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// saveCursor persists the cursor with ds. The in-memory cursor is moved separately, once ds is committed.
+func (l *listener) saveCursor(ctx context.Context, ds sqlutil.DataSource, block, logIndex int64) error {
+	_, err := ds.ExecContext(ctx, `UPDATE log_trigger_specs SET cursor_block = $1, cursor_log_index = $2, updated_at = NOW() WHERE id = $3`,
+		block, logIndex, l.job.LogTriggerSpec.ID)
+	return errors.Wrap(err, "failed to save log trigger cursor")
+}
+
+func (l *listener) moveCursor(block, logIndex int64) {
+	l.cursorBlock, l.cursorLogIndex, l.hasCursor = block, logIndex, true
+}
@@ -0,0 +1,212 @@
+package logtrigger_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/common/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/logtrigger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+const (
+	testContractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+	testFilteredTo      = "0x0000000000000000000000000000000000000000000000000000000000000abc"
+)
+
+func setupLogTriggerJob(t *testing.T) (job.ORM, sqlutil.DataSource, job.Job) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), keyStore, lggr)
+
+	jb := logTriggerJob(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+	jb, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	return jobORM, db, jb
+}
+
+func logTriggerJob(t *testing.T) job.Job {
+	jb, err := logtrigger.ValidatedLogTriggerSpec(`
+type             = "logtrigger"
+schemaVersion    = 1
+evmChainID       = 1337
+contractAddress  = "` + testContractAddress + `"
+eventABI         = "Transfer(address indexed from, address indexed to, uint256 value)"
+topic2           = ["` + testFilteredTo + `"]
+minConfirmations = 2
+fromBlock        = 10
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`)
+	require.NoError(t, err)
+	return jb
+}
+
+func transferLog(eventID common.Hash, blockNumber, logIndex int64, to string, value int64) logpoller.Log {
+	return logpoller.Log{
+		BlockNumber: blockNumber,
+		LogIndex:    logIndex,
+		Address:     common.HexToAddress(testContractAddress),
+		EventSig:    eventID,
+		Topics: pq.ByteaArray{
+			eventID.Bytes(),
+			common.HexToHash("0x01").Bytes(),
+			common.HexToHash(to).Bytes(),
+		},
+		Data: common.LeftPadBytes(big.NewInt(value).Bytes(), 32),
+	}
+}
+
+func TestListener_Poll(t *testing.T) {
+	ctx := testutils.Context(t)
+	jobORM, db, jb := setupLogTriggerJob(t)
+	lp := lpmocks.NewLogPoller(t)
+	runner := pipelinemocks.NewRunner(t)
+
+	l, err := logtrigger.NewTestListener(jb, lp, runner, db, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	malformed := transferLog(l.ExportedEventID(), 16, 0, testFilteredTo, 0)
+	malformed.Data = []byte{1, 2, 3}
+	lp.On("Replay", mock.Anything, int64(10)).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 20}, nil).Once()
+	lp.On("LogsWithSigs", mock.Anything, int64(10), int64(18), []common.Hash{l.ExportedEventID()}, common.HexToAddress(testContractAddress)).
+		Return([]logpoller.Log{
+			transferLog(l.ExportedEventID(), 15, 3, testFilteredTo, 2),
+			malformed,
+			transferLog(l.ExportedEventID(), 12, 1, testFilteredTo, 1),
+			transferLog(l.ExportedEventID(), 12, 0, "0x0def", 9),
+		}, nil).Once()
+
+	var values []int64
+	runner.On("Run", mock.Anything, mock.Anything, true, mock.Anything).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			vars := run.Inputs.Val.(map[string]any)
+			decoded := vars["log"].(map[string]any)
+			assert.Equal(t, common.HexToAddress("0x01"), decoded["from"])
+			assert.Equal(t, common.HexToAddress(testFilteredTo), decoded["to"])
+			values = append(values, decoded["value"].(*big.Int).Int64())
+			fn := args.Get(3).(func(sqlutil.DataSource) error)
+			require.NoError(t, fn(db))
+		}).
+		Return(false, nil).Twice()
+
+	l.ExportedPoll(ctx)
+
+	assert.Equal(t, []int64{1, 2}, values)
+	jb, err = jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(19), jb.LogTriggerSpec.CursorBlock.Int64)
+	assert.Equal(t, int64(0), jb.LogTriggerSpec.CursorLogIndex)
+
+	// The listener of the restarted job resumes from the cursor, without replaying.
+	l, err = logtrigger.NewTestListener(jb, lp, runner, db, logger.TestLogger(t))
+	require.NoError(t, err)
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 22}, nil).Once()
+	lp.On("LogsWithSigs", mock.Anything, int64(19), int64(20), []common.Hash{l.ExportedEventID()}, common.HexToAddress(testContractAddress)).
+		Return([]logpoller.Log{}, nil).Once()
+
+	l.ExportedPoll(ctx)
+
+	cursorBlock, _ := l.ExportedCursor()
+	assert.Equal(t, int64(21), cursorBlock)
+}
+
+func TestListener_KeepsCursor(t *testing.T) {
+	ctx := testutils.Context(t)
+	jobORM, _, _ := setupLogTriggerJob(t)
+
+	// a replaced job is re-inserted with the cursor of the old spec
+	jb := logTriggerJob(t)
+	jb.LogTriggerSpec.CursorBlock, jb.LogTriggerSpec.CursorLogIndex = null.IntFrom(42), 3
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+
+	found, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, null.IntFrom(42), found.LogTriggerSpec.CursorBlock)
+	assert.Equal(t, int64(3), found.LogTriggerSpec.CursorLogIndex)
+}
+
+func TestListener_Poll_RetriesFailedRun(t *testing.T) {
+	ctx := testutils.Context(t)
+	jobORM, db, jb := setupLogTriggerJob(t)
+	lp := lpmocks.NewLogPoller(t)
+	runner := pipelinemocks.NewRunner(t)
+
+	l, err := logtrigger.NewTestListener(jb, lp, runner, db, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	logs := []logpoller.Log{
+		transferLog(l.ExportedEventID(), 12, 1, testFilteredTo, 1),
+		transferLog(l.ExportedEventID(), 15, 3, testFilteredTo, 2),
+	}
+	lp.On("Replay", mock.Anything, int64(10)).Return(nil).Once()
+	lp.On("LatestBlock", mock.Anything).Return(logpoller.Block{BlockNumber: 20}, nil)
+	lp.On("LogsWithSigs", mock.Anything, int64(10), int64(18), mock.Anything, mock.Anything).Return(logs, nil).Once()
+
+	saveCursor := func(args mock.Arguments) {
+		require.NoError(t, args.Get(3).(func(sqlutil.DataSource) error)(db))
+	}
+	runner.On("Run", mock.Anything, mock.Anything, true, mock.Anything).Run(saveCursor).Return(false, nil).Once()
+	runner.On("Run", mock.Anything, mock.Anything, true, mock.Anything).
+		Run(func(args mock.Arguments) {
+			// the cursor is saved in the transaction of the run, which is rolled back
+			err := sqlutil.TransactDataSource(ctx, db, nil, func(tx sqlutil.DataSource) error {
+				require.NoError(t, args.Get(3).(func(sqlutil.DataSource) error)(tx))
+				return assert.AnError
+			})
+			require.ErrorIs(t, err, assert.AnError)
+		}).
+		Return(false, assert.AnError).Once()
+
+	l.ExportedPoll(ctx)
+
+	jb, err = jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), jb.LogTriggerSpec.CursorBlock.Int64)
+	assert.Equal(t, int64(2), jb.LogTriggerSpec.CursorLogIndex)
+	cursorBlock, cursorLogIndex := l.ExportedCursor()
+	assert.Equal(t, int64(12), cursorBlock)
+	assert.Equal(t, int64(2), cursorLogIndex)
+
+	// The next poll starts over from the failed log, without running the first one again.
+	lp.On("LogsWithSigs", mock.Anything, int64(12), int64(18), mock.Anything, mock.Anything).Return(logs, nil).Once()
+	runner.On("Run", mock.Anything, mock.Anything, true, mock.Anything).
+		Run(func(args mock.Arguments) {
+			vars := args.Get(1).(*pipeline.Run).Inputs.Val.(map[string]any)
+			assert.Equal(t, int64(15), vars["jobRun"].(map[string]any)["logBlockNumber"])
+			saveCursor(args)
+		}).
+		Return(false, nil).Once()
+
+	l.ExportedPoll(ctx)
+
+	cursorBlock, cursorLogIndex = l.ExportedCursor()
+	assert.Equal(t, int64(19), cursorBlock)
+	assert.Equal(t, int64(0), cursorLogIndex)
+}
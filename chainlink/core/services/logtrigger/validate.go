@@ -0,0 +1,97 @@
+package logtrigger
+
+import (
+	stderrors "errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lib/pq"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// DefaultPollPeriod is used when the spec does not set pollPeriod.
+const DefaultPollPeriod = 5 * time.Second
+
+func ValidatedLogTriggerSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{}
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, err
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, err
+	}
+	var spec job.LogTriggerSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, err
+	}
+	jb.LogTriggerSpec = &spec
+
+	for _, topic := range []*pq.StringArray{&spec.Topic1, &spec.Topic2, &spec.Topic3} {
+		if *topic == nil {
+			// Empty but non-null, fields are non-nullable.
+			*topic = pq.StringArray{}
+		}
+	}
+
+	if jb.Type != job.LogTrigger {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if spec.EVMChainID == nil {
+		err = stderrors.Join(err, errors.New("evmChainID must be set"))
+	}
+	if spec.ContractAddress.Address() == (common.Address{}) {
+		err = stderrors.Join(err, errors.New("contractAddress must be set"))
+	}
+	if spec.FromBlock < 0 {
+		err = stderrors.Join(err, errors.Errorf("fromBlock must not be negative, got %d", spec.FromBlock))
+	}
+	if spec.PollPeriod < 0 {
+		err = stderrors.Join(err, errors.Errorf("pollPeriod must not be negative, got %s", spec.PollPeriod))
+	} else if spec.PollPeriod == 0 {
+		spec.PollPeriod = DefaultPollPeriod
+	}
+	if _, _, parseErr := parseEvent(spec); parseErr != nil {
+		err = stderrors.Join(err, parseErr)
+	}
+	return jb, err
+}
+
+// parseEvent parses the event ABI of spec and its topic filters, which are indexed by the position of the
+// indexed argument they apply to.
+func parseEvent(spec job.LogTriggerSpec) (event abi.Event, topics [3][]common.Hash, err error) {
+	event, err = pipeline.ParseETHABIEventString([]byte(spec.EventABI))
+	if err != nil {
+		return event, topics, errors.Wrap(err, "invalid eventABI")
+	}
+
+	var indexed int
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed++
+		}
+	}
+	for i, values := range [3][]string{spec.Topic1, spec.Topic2, spec.Topic3} {
+		if len(values) > 0 && i >= indexed {
+			err = stderrors.Join(err, errors.Errorf("topic%d is set, but eventABI only has %d indexed arguments", i+1, indexed))
+			continue
+		}
+		for _, value := range values {
+			b, decodeErr := hexutil.Decode(value)
+			if decodeErr != nil || len(b) != common.HashLength {
+				err = stderrors.Join(err, errors.Errorf("topic%d value %q must be a 32 byte hex string", i+1, value))
+				continue
+			}
+			topics[i] = append(topics[i], common.BytesToHash(b))
+		}
+	}
+	return event, topics, err
+}
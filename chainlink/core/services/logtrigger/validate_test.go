@@ -0,0 +1,182 @@
+package logtrigger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/logtrigger"
+)
+
+const transferABI = `Transfer(address indexed from, address indexed to, uint256 value)`
+
+func TestValidatedLogTriggerSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "valid spec",
+			toml: `
+type             = "logtrigger"
+schemaVersion    = 1
+evmChainID       = 1337
+contractAddress  = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI         = "` + transferABI + `"
+topic2           = ["0x0000000000000000000000000000000000000000000000000000000000000abc"]
+minConfirmations = 3
+fromBlock        = 100
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.LogTriggerSpec)
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", jb.LogTriggerSpec.ContractAddress.String())
+				assert.Equal(t, transferABI, jb.LogTriggerSpec.EventABI)
+				assert.Empty(t, jb.LogTriggerSpec.Topic1)
+				assert.Len(t, jb.LogTriggerSpec.Topic2, 1)
+				assert.Equal(t, uint32(3), jb.LogTriggerSpec.MinConfirmations)
+				assert.Equal(t, int64(100), jb.LogTriggerSpec.FromBlock)
+				assert.Equal(t, logtrigger.DefaultPollPeriod, jb.LogTriggerSpec.PollPeriod)
+				assert.Equal(t, "1337", jb.LogTriggerSpec.EVMChainID.String())
+			},
+		},
+		{
+			name: "custom poll period",
+			toml: `
+type            = "logtrigger"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "` + transferABI + `"
+pollPeriod      = "1m"
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, time.Minute, jb.LogTriggerSpec.PollPeriod)
+			},
+		},
+		{
+			name: "missing chain ID and contract address",
+			toml: `
+type          = "logtrigger"
+schemaVersion = 1
+eventABI      = "` + transferABI + `"
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "evmChainID must be set")
+				assert.Contains(t, err.Error(), "contractAddress must be set")
+			},
+		},
+		{
+			name: "invalid event ABI",
+			toml: `
+type            = "logtrigger"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "Transfer(address indexed from"
+observationSource = """
+ds [type=memo value="1"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid eventABI")
+			},
+		},
+		{
+			name: "topic filter on a non-indexed argument",
+			toml: `
+type            = "logtrigger"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "` + transferABI + `"
+topic3          = ["0x0000000000000000000000000000000000000000000000000000000000000001"]
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "topic3 is set, but eventABI only has 2 indexed arguments")
+			},
+		},
+		{
+			name: "malformed topic value",
+			toml: `
+type            = "logtrigger"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "` + transferABI + `"
+topic1          = ["0xabc"]
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `topic1 value "0xabc" must be a 32 byte hex string`)
+			},
+		},
+		{
+			name: "negative fromBlock and pollPeriod",
+			toml: `
+type            = "logtrigger"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "` + transferABI + `"
+fromBlock       = -1
+pollPeriod      = "-1s"
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "fromBlock must not be negative")
+				assert.Contains(t, err.Error(), "pollPeriod must not be negative")
+			},
+		},
+		{
+			name: "wrong type",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+evmChainID      = 1337
+contractAddress = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI        = "` + transferABI + `"
+observationSource = """
+ds [type=memo value="$(log.value)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unsupported type cron")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			jb, err := logtrigger.ValidatedLogTriggerSpec(tc.toml)
+			tc.assertion(t, jb, err)
+		})
+	}
+}
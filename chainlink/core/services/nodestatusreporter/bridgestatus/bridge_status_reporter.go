@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -24,11 +25,12 @@ type Service struct {
 	services.Service
 	eng *services.Engine
 
-	config     config.BridgeStatusReporter
-	bridgeORM  bridges.ORM
-	jobORM     job.ORM
-	httpClient *http.Client
-	emitter    beholder.Emitter
+	config       config.BridgeStatusReporter
+	bridgeORM    bridges.ORM
+	bridgeHealth *bridges.HealthChecker
+	jobORM       job.ORM
+	httpClient   *http.Client
+	emitter      beholder.Emitter
 }
 
 const (
@@ -40,17 +42,19 @@ const (
 func NewBridgeStatusReporter(
 	config config.BridgeStatusReporter,
 	bridgeORM bridges.ORM,
+	bridgeHealth *bridges.HealthChecker,
 	jobORM job.ORM,
 	httpClient *http.Client,
 	emitter beholder.Emitter,
 	lggr logger.Logger,
 ) *Service {
 	s := &Service{
-		config:     config,
-		bridgeORM:  bridgeORM,
-		jobORM:     jobORM,
-		httpClient: httpClient,
-		emitter:    emitter,
+		config:       config,
+		bridgeORM:    bridgeORM,
+		bridgeHealth: bridgeHealth,
+		jobORM:       jobORM,
+		httpClient:   httpClient,
+		emitter:      emitter,
 	}
 	s.Service, s.eng = services.Config{
 		Name:  ServiceName,
@@ -115,10 +119,12 @@ func (s *Service) pollAllBridges(ctx context.Context) {
 	for _, bridge := range allBridges {
 		wg.Add(1)
 		bridgeName := string(bridge.Name)
-		bridgeURL := bridge.URL.String()
+		// the status is fetched from the endpoint the bridge task would call first
+		bridgeURL := s.bridgeHealth.Endpoints(bridge)[0].String()
+		health := s.bridgeHealth.Health(bridge)
 		go func(name, url string) {
 			defer wg.Done()
-			s.pollBridge(ctx, name, url)
+			s.pollBridge(ctx, name, url, health)
 		}(bridgeName, bridgeURL)
 	}
 
@@ -126,17 +132,17 @@ func (s *Service) pollAllBridges(ctx context.Context) {
 }
 
 // handleBridgeError handles errors during bridge polling, either skipping or emitting empty telemetry
-func (s *Service) handleBridgeError(ctx context.Context, bridgeName string, jobs []JobInfo, logMsg string, logFields ...any) {
+func (s *Service) handleBridgeError(ctx context.Context, bridgeName string, jobs []JobInfo, health []bridges.EndpointHealth, logMsg string, logFields ...any) {
 	s.eng.Debugw(logMsg, logFields...)
 	if s.config.IgnoreInvalidBridges() {
 		return
 	}
 	// If not ignoring invalid bridges, still emit empty telemetry
-	s.emitBridgeStatus(ctx, bridgeName, EAResponse{}, jobs)
+	s.emitBridgeStatus(ctx, bridgeName, EAResponse{}, jobs, health)
 }
 
-// pollBridge polls a single bridge's status endpoint
-func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL string) {
+// pollBridge polls a single bridge's status endpoint, health is the health of the bridge endpoints
+func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL string, health []bridges.EndpointHealth) {
 	s.eng.Debugw("Polling bridge", "bridge", bridgeName, "url", bridgeURL)
 
 	// Look up jobs associated with this bridge first
@@ -155,7 +161,7 @@ func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL s
 	// Parse bridge URL and construct status endpoint
 	parsedURL, err := url.Parse(bridgeURL)
 	if err != nil {
-		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to parse bridge URL", "bridge", bridgeName, "url", bridgeURL, "error", err)
+		s.handleBridgeError(ctx, bridgeName, jobs, health, "Failed to parse bridge URL", "bridge", bridgeName, "url", bridgeURL, "error", err)
 		return
 	}
 
@@ -169,37 +175,37 @@ func (s *Service) pollBridge(ctx context.Context, bridgeName string, bridgeURL s
 	// Make HTTP request
 	req, err := http.NewRequestWithContext(ctx, "GET", statusURL.String(), nil)
 	if err != nil {
-		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to create request for Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
+		s.handleBridgeError(ctx, bridgeName, jobs, health, "Failed to create request for Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
 		return
 	}
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to fetch Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
+		s.handleBridgeError(ctx, bridgeName, jobs, health, "Failed to fetch Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
 		return
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		s.handleBridgeError(ctx, bridgeName, jobs, "Bridge Status Reporter status endpoint returned non-200 status", "bridge", bridgeName, "url", statusURL.String(), "status", resp.StatusCode)
+		s.handleBridgeError(ctx, bridgeName, jobs, health, "Bridge Status Reporter status endpoint returned non-200 status", "bridge", bridgeName, "url", statusURL.String(), "status", resp.StatusCode)
 		return
 	}
 
 	// Parse response
 	var status EAResponse
 	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
-		s.handleBridgeError(ctx, bridgeName, jobs, "Failed to decode Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
+		s.handleBridgeError(ctx, bridgeName, jobs, health, "Failed to decode Bridge Status Reporter status", "bridge", bridgeName, "url", statusURL.String(), "error", err)
 		return
 	}
 
 	s.eng.Debugw("Successfully fetched Bridge Status Reporter status", "bridge", bridgeName, "adapter", status.Adapter.Name, "version", status.Adapter.Version)
 
 	// Emit telemetry to Beholder
-	s.emitBridgeStatus(ctx, bridgeName, status, jobs)
+	s.emitBridgeStatus(ctx, bridgeName, status, jobs, health)
 }
 
 // emitBridgeStatus sends Bridge Status Reporter data to Beholder
-func (s *Service) emitBridgeStatus(ctx context.Context, bridgeName string, status EAResponse, jobs []JobInfo) {
+func (s *Service) emitBridgeStatus(ctx context.Context, bridgeName string, status EAResponse, jobs []JobInfo, health []bridges.EndpointHealth) {
 	// Convert runtime info
 	runtime := &events.RuntimeInfo{
 		NodeVersion:  status.Runtime.NodeVersion,
@@ -255,6 +261,19 @@ func (s *Service) emitBridgeStatus(ctx context.Context, bridgeName string, statu
 		})
 	}
 
+	// Convert the health of the bridge endpoints
+	healthProto := make([]*events.EndpointHealth, len(health))
+	for i, eh := range health {
+		healthProto[i] = &events.EndpointHealth{
+			Url:    eh.URL,
+			Status: string(eh.Status),
+			Error:  eh.Error,
+		}
+		if eh.CheckedAt != nil {
+			healthProto[i].CheckedAt = eh.CheckedAt.Format(time.RFC3339Nano)
+		}
+	}
+
 	// Create the protobuf event
 	event := &events.BridgeStatusEvent{
 		BridgeName:           bridgeName,
@@ -267,6 +286,7 @@ func (s *Service) emitBridgeStatus(ctx context.Context, bridgeName string, statu
 		Endpoints:            endpointsProto,
 		Configuration:        configProto,
 		Jobs:                 jobsProto,
+		EndpointHealth:       healthProto,
 	}
 
 	// Emit the protobuf event through the configured emitter
@@ -99,7 +99,7 @@ func setupTestService(t *testing.T, enabled bool, pollingInterval time.Duration,
 	// Reduce log noise
 	lggr.SetLogLevel(zapcore.ErrorLevel)
 
-	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, jobORM, httpClient, emitter, lggr)
+	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, nil, jobORM, httpClient, emitter, lggr)
 
 	return service, bridgeORM, jobORM, emitter
 }
@@ -118,7 +118,7 @@ func setupTestServiceWithIgnoreFlags(t *testing.T, enabled bool, pollingInterval
 	// Reduce log noise
 	lggr.SetLogLevel(zapcore.ErrorLevel)
 
-	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, jobORM, httpClient, emitter, lggr)
+	service := NewBridgeStatusReporter(bridgeStatusConfig, bridgeORM, nil, jobORM, httpClient, emitter, lggr)
 
 	return service, bridgeORM, jobORM, emitter
 }
@@ -229,7 +229,7 @@ func TestService_pollBridge_Success(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, "test-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -246,7 +246,7 @@ func TestService_pollBridge_HTTPError(t *testing.T) {
 
 	// Should handle HTTP error gracefully
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080")
+		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080", nil)
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -264,7 +264,7 @@ func TestService_pollBridge_InvalidJSON(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080")
+		service.pollBridge(ctx, "test-bridge", "http://invalid.invalid:8080", nil)
 	})
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
 	emitter.AssertNotCalled(t, "With", mock.Anything)
@@ -282,7 +282,7 @@ func TestService_pollBridge_InvalidURL(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "://invalid-url")
+		service.pollBridge(ctx, "test-bridge", "://invalid-url", nil)
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -301,7 +301,7 @@ func TestService_pollBridge_EmptyURL(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "")
+		service.pollBridge(ctx, "test-bridge", "", nil)
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -326,7 +326,7 @@ func TestService_pollBridge_URLPathPreservation(t *testing.T) {
 	ctx := context.Background()
 
 	// If URL path joining is broken, this will fail with "unexpected URL" error
-	service.pollBridge(ctx, "test-bridge", "http://localhost:8080/bridge/v1")
+	service.pollBridge(ctx, "test-bridge", "http://localhost:8080/bridge/v1", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -342,7 +342,7 @@ func TestService_pollBridge_Non200Status(t *testing.T) {
 	ctx := context.Background()
 
 	assert.NotPanics(t, func() {
-		service.pollBridge(ctx, "test-bridge", "http://example.com")
+		service.pollBridge(ctx, "test-bridge", "http://example.com", nil)
 	})
 
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -357,7 +357,7 @@ func TestService_emitBridgeStatus_Success(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.emitBridgeStatus(ctx, "test-bridge", loadFixtureAsEAResponse(t, "bridge_status_response.json"), []JobInfo{})
+	service.emitBridgeStatus(ctx, "test-bridge", loadFixtureAsEAResponse(t, "bridge_status_response.json"), []JobInfo{}, nil)
 
 	emitter.AssertExpectations(t)
 }
@@ -431,6 +431,7 @@ func TestService_emitBridgeStatus_CaptureOutput(t *testing.T) {
 	service := NewBridgeStatusReporter(
 		config,
 		nil, // bridgeORM not needed for this test
+		nil, // bridgeHealth not needed for this test
 		nil, // jobORM not needed for this test
 		nil, // httpClient not needed for this test
 		emitter,
@@ -440,7 +441,7 @@ func TestService_emitBridgeStatus_CaptureOutput(t *testing.T) {
 	// Load fixture and emit
 	ctx := context.Background()
 	status := loadFixtureAsEAResponse(t, "bridge_status_response.json")
-	service.emitBridgeStatus(ctx, "test-bridge", status, []JobInfo{})
+	service.emitBridgeStatus(ctx, "test-bridge", status, []JobInfo{}, nil)
 
 	// Unmarshal and verify protobuf matches fixture values
 	require.NotEmpty(t, capturedProtobufBytes)
@@ -637,6 +638,7 @@ func TestService_emitBridgeStatus_EmptyFields(t *testing.T) {
 	service := NewBridgeStatusReporter(
 		config,
 		nil, // bridgeORM not needed for this test
+		nil, // bridgeHealth not needed for this test
 		nil, // jobORM not needed for this test
 		nil, // httpClient not needed for this test
 		emitter,
@@ -646,7 +648,7 @@ func TestService_emitBridgeStatus_EmptyFields(t *testing.T) {
 	// Load empty fixture and emit
 	ctx := context.Background()
 	status := loadFixtureAsEAResponse(t, "bridge_status_empty.json")
-	service.emitBridgeStatus(ctx, "empty-bridge", status, []JobInfo{})
+	service.emitBridgeStatus(ctx, "empty-bridge", status, []JobInfo{}, nil)
 
 	// Unmarshal and verify protobuf handles empty values correctly
 	require.NotEmpty(t, capturedProtobufBytes)
@@ -679,6 +681,38 @@ func TestService_emitBridgeStatus_EmptyFields(t *testing.T) {
 	emitter.AssertExpectations(t)
 }
 
+func TestService_emitBridgeStatus_EndpointHealth(t *testing.T) {
+	emitter := mocks.NewBeholderEmitter()
+	var capturedProtobufBytes []byte
+
+	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		capturedProtobufBytes = args.Get(1).([]byte)
+	})
+
+	config := mocks.NewTestBridgeStatusReporterConfig(true, "/status", 5*time.Minute)
+	service := NewBridgeStatusReporter(config, nil, nil, nil, nil, emitter, logger.TestLogger(t))
+
+	checkedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	health := []bridges.EndpointHealth{
+		{URL: testBridgeURL1, Status: bridges.EndpointStatusUnhealthy, Error: "health check returned status 503", CheckedAt: &checkedAt},
+		{URL: testBridgeURL2, Status: bridges.EndpointStatusUnknown},
+	}
+	service.emitBridgeStatus(context.Background(), "test-bridge", EAResponse{}, []JobInfo{}, health)
+
+	require.NotEmpty(t, capturedProtobufBytes)
+	var event events.BridgeStatusEvent
+	require.NoError(t, proto.Unmarshal(capturedProtobufBytes, &event))
+
+	require.Len(t, event.EndpointHealth, 2)
+	assert.Equal(t, testBridgeURL1, event.EndpointHealth[0].Url)
+	assert.Equal(t, "unhealthy", event.EndpointHealth[0].Status)
+	assert.Equal(t, "health check returned status 503", event.EndpointHealth[0].Error)
+	assert.Equal(t, "2025-01-02T03:04:05Z", event.EndpointHealth[0].CheckedAt)
+	assert.Equal(t, testBridgeURL2, event.EndpointHealth[1].Url)
+	assert.Equal(t, "unknown", event.EndpointHealth[1].Status)
+	assert.Empty(t, event.EndpointHealth[1].CheckedAt)
+}
+
 // Test for external job IDs and job names functionality
 func TestService_pollBridge_WithJobInfo(t *testing.T) {
 	httpClient := mocks.NewMockHTTPClient(loadFixture(t, "bridge_status_response.json"), http.StatusOK)
@@ -710,7 +744,7 @@ func TestService_pollBridge_WithJobInfo(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, "test-bridge", "http://example.com", nil)
 
 	// Verify the job information (IDs and names) were included in the protobuf
 	require.NotEmpty(t, capturedProtobufBytes)
@@ -740,7 +774,7 @@ func TestService_pollBridge_JobORMError(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", "http://example.com")
+	service.pollBridge(ctx, "test-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -759,7 +793,7 @@ func TestService_pollBridge_IgnoreJoblessBridges_Enabled(t *testing.T) {
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-bridge", "http://example.com")
+	service.pollBridge(ctx, "jobless-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -777,7 +811,7 @@ func TestService_pollBridge_IgnoreJoblessBridges_Disabled(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-bridge", "http://example.com")
+	service.pollBridge(ctx, "jobless-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -796,7 +830,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_HTTPError_Enabled(t *testing.T)
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080")
+	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080", nil)
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -821,7 +855,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_HTTPError_Disabled(t *testing.T
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080") // This will fail with HTTP error
+	service.pollBridge(ctx, "invalid-bridge", "http://invalid.invalid:8080", nil) // This will fail with HTTP error
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -840,7 +874,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_Non200Status_Enabled(t *testing
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, "invalid-bridge", "http://example.com", nil)
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -865,7 +899,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_Non200Status_Disabled(t *testin
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, "invalid-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -884,7 +918,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_InvalidJSON_Enabled(t *testing.
 	jobORM.On("FindJob", mock.Anything, int32(1)).Return(testJob, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, "invalid-bridge", "http://example.com", nil)
 
 	// Should NOT emit telemetry for invalid bridge when ignoreInvalidBridges is true
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -909,7 +943,7 @@ func TestService_pollBridge_IgnoreInvalidBridges_InvalidJSON_Disabled(t *testing
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "invalid-bridge", "http://example.com")
+	service.pollBridge(ctx, "invalid-bridge", "http://example.com", nil)
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -924,7 +958,7 @@ func TestService_pollBridge_BothIgnoreFlags_Enabled(t *testing.T) {
 	jobORM.On("FindJobIDsWithBridge", mock.Anything, "jobless-invalid-bridge").Return([]int32{}, nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080") // This would fail with HTTP error too
+	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080", nil) // This would fail with HTTP error too
 
 	// Should NOT emit telemetry - skipped because of no jobs (ignoreJoblessBridges)
 	emitter.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything)
@@ -945,7 +979,7 @@ func TestService_pollBridge_BothIgnoreFlags_Disabled(t *testing.T) {
 	emitter.On("Emit", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080") // This will fail with HTTP error
+	service.pollBridge(ctx, "jobless-invalid-bridge", "http://invalid.invalid:8080", nil) // This will fail with HTTP error
 
 	jobORM.AssertExpectations(t)
 	emitter.AssertExpectations(t)
@@ -980,7 +1014,7 @@ func TestService_pollBridge_EndToEnd_RealWebServer(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	service.pollBridge(ctx, "test-bridge", server.URL)
+	service.pollBridge(ctx, "test-bridge", server.URL, nil)
 
 	// Verify the complete end-to-end flow worked
 	require.NotEmpty(t, capturedProtobufBytes, "Should have emitted protobuf data")
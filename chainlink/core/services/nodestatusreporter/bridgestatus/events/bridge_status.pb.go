@@ -94,9 +94,11 @@ type BridgeStatusEvent struct {
 	// Jobs associated with this bridge
 	Jobs []*JobInfo `protobuf:"bytes,10,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	// Event metadata
-	Timestamp     string `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Timestamp string `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Health of the bridge endpoints, in failover order
+	EndpointHealth []*EndpointHealth `protobuf:"bytes,12,rep,name=endpoint_health,json=endpointHealth,proto3" json:"endpoint_health,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BridgeStatusEvent) Reset() {
@@ -206,6 +208,13 @@ func (x *BridgeStatusEvent) GetTimestamp() string {
 	return ""
 }
 
+func (x *BridgeStatusEvent) GetEndpointHealth() []*EndpointHealth {
+	if x != nil {
+		return x.EndpointHealth
+	}
+	return nil
+}
+
 type RuntimeInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeVersion   string                 `protobuf:"bytes,1,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
@@ -478,6 +487,75 @@ func (x *ConfigurationItem) GetEnvDefaultOverride() string {
 	return ""
 }
 
+// EndpointHealth represents the last health check of a bridge endpoint
+type EndpointHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedAt     string                 `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndpointHealth) Reset() {
+	*x = EndpointHealth{}
+	mi := &file_bridge_status_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointHealth) ProtoMessage() {}
+
+func (x *EndpointHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_bridge_status_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointHealth.ProtoReflect.Descriptor instead.
+func (*EndpointHealth) Descriptor() ([]byte, []int) {
+	return file_bridge_status_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EndpointHealth) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EndpointHealth) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
 var File_bridge_status_proto protoreflect.FileDescriptor
 
 const file_bridge_status_proto_rawDesc = "" +
@@ -485,7 +563,7 @@ const file_bridge_status_proto_rawDesc = "" +
 	"\x13bridge_status.proto\x12\x10bridge_status.v1\"L\n" +
 	"\aJobInfo\x12&\n" +
 	"\x0fexternal_job_id\x18\x01 \x01(\tR\rexternalJobId\x12\x19\n" +
-	"\bjob_name\x18\x02 \x01(\tR\ajobName\"\xa9\x04\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\"\xf4\x04\n" +
 	"\x11BridgeStatusEvent\x12\x1f\n" +
 	"\vbridge_name\x18\x01 \x01(\tR\n" +
 	"bridgeName\x12!\n" +
@@ -499,7 +577,8 @@ const file_bridge_status_proto_rawDesc = "" +
 	"\rconfiguration\x18\t \x03(\v2#.bridge_status.v1.ConfigurationItemR\rconfiguration\x12-\n" +
 	"\x04jobs\x18\n" +
 	" \x03(\v2\x19.bridge_status.v1.JobInfoR\x04jobs\x12\x1c\n" +
-	"\ttimestamp\x18\v \x01(\tR\ttimestamp\"\x8c\x01\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12I\n" +
+	"\x0fendpoint_health\x18\f \x03(\v2 .bridge_status.v1.EndpointHealthR\x0eendpointHealth\"\x8c\x01\n" +
 	"\vRuntimeInfo\x12!\n" +
 	"\fnode_version\x18\x01 \x01(\tR\vnodeVersion\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\"\n" +
@@ -521,7 +600,13 @@ const file_bridge_status_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12#\n" +
 	"\rdefault_value\x18\x06 \x01(\tR\fdefaultValue\x12%\n" +
 	"\x0ecustom_setting\x18\a \x01(\bR\rcustomSetting\x120\n" +
-	"\x14env_default_override\x18\b \x01(\tR\x12envDefaultOverrideB_Z]github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus/eventsb\x06proto3"
+	"\x14env_default_override\x18\b \x01(\tR\x12envDefaultOverride\"o\n" +
+	"\x0eEndpointHealth\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\tR\tcheckedAtB_Z]github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus/eventsb\x06proto3"
 
 var (
 	file_bridge_status_proto_rawDescOnce sync.Once
@@ -535,7 +620,7 @@ func file_bridge_status_proto_rawDescGZIP() []byte {
 	return file_bridge_status_proto_rawDescData
 }
 
-var file_bridge_status_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_bridge_status_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_bridge_status_proto_goTypes = []any{
 	(*JobInfo)(nil),           // 0: bridge_status.v1.JobInfo
 	(*BridgeStatusEvent)(nil), // 1: bridge_status.v1.BridgeStatusEvent
@@ -543,6 +628,7 @@ var file_bridge_status_proto_goTypes = []any{
 	(*MetricsInfo)(nil),       // 3: bridge_status.v1.MetricsInfo
 	(*EndpointInfo)(nil),      // 4: bridge_status.v1.EndpointInfo
 	(*ConfigurationItem)(nil), // 5: bridge_status.v1.ConfigurationItem
+	(*EndpointHealth)(nil),    // 6: bridge_status.v1.EndpointHealth
 }
 var file_bridge_status_proto_depIdxs = []int32{
 	2, // 0: bridge_status.v1.BridgeStatusEvent.runtime:type_name -> bridge_status.v1.RuntimeInfo
@@ -550,11 +636,12 @@ var file_bridge_status_proto_depIdxs = []int32{
 	4, // 2: bridge_status.v1.BridgeStatusEvent.endpoints:type_name -> bridge_status.v1.EndpointInfo
 	5, // 3: bridge_status.v1.BridgeStatusEvent.configuration:type_name -> bridge_status.v1.ConfigurationItem
 	0, // 4: bridge_status.v1.BridgeStatusEvent.jobs:type_name -> bridge_status.v1.JobInfo
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: bridge_status.v1.BridgeStatusEvent.endpoint_health:type_name -> bridge_status.v1.EndpointHealth
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_bridge_status_proto_init() }
@@ -568,7 +655,7 @@ func file_bridge_status_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bridge_status_proto_rawDesc), len(file_bridge_status_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -111,10 +111,11 @@ func newTestPipelineGetter(t *testing.T, source string) *pricegetter.PipelineGet
 	cfg.On("DefaultHTTPTimeout").Return(*config2.MustNewDuration(time.Second))
 	cfg.On("DefaultHTTPLimit").Return(int64(1024 * 10))
 	cfg.On("VerboseLogging").Return(true)
+	cfg.On("CircuitBreakerEnabled").Return(false)
 	db := pgtest.NewSqlxDB(t)
 	bridgeORM := bridges.NewORM(db)
 	runner := pipeline.NewRunner(pipeline.NewORM(db, lggr, config.NewTestGeneralConfig(t).JobPipeline().MaxSuccessfulRuns()),
-		bridgeORM, cfg, nil, nil, nil, nil, lggr, &http.Client{}, &http.Client{})
+		bridgeORM, nil, cfg, nil, nil, nil, nil, lggr, &http.Client{}, &http.Client{})
 	sourceNative := ccipcalc.EvmAddrToGeneric(common.HexToAddress("0x"))
 	sourceChain := chainsel.TEST_1000
 	destChain := chainsel.TEST_1338
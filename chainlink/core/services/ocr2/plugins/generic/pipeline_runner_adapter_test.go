@@ -51,6 +51,7 @@ func TestAdapter_Integration(t *testing.T) {
 	pr := pipeline.NewRunner(
 		pipelineORM,
 		bridgesORM,
+		nil,
 		cfg.JobPipeline(),
 		cfg.WebServer(),
 		nil,
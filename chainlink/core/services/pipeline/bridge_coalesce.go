@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var promBridgeCoalesced = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bridge_coalesced_total",
+	Help: "Number of bridge task runs which shared the response of a concurrent identical request, scoped by name",
+},
+	[]string{"name"},
+)
+
+// bridgeCoalescer coalesces concurrent identical bridge requests, across all
+// jobs on the node, into a single call to the external adapter.
+//
+// A nil *bridgeCoalescer is valid and coalesces nothing.
+type bridgeCoalescer struct {
+	group singleflight.Group
+}
+
+func newBridgeCoalescer() *bridgeCoalescer {
+	return &bridgeCoalescer{}
+}
+
+// bridgeCoalesceKey derives a key from the bridge name, headers and request
+// body. requestDataJSON must be canonical, which json.Marshal guarantees for
+// maps by sorting their keys.
+func bridgeCoalesceKey(name string, reqHeaders []string, requestDataJSON []byte) string {
+	h := sha256.New()
+	for _, part := range append([]string{name}, reqHeaders...) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(requestDataJSON)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// do executes fn once for all concurrent callers with the same key, see
+// doShared.
+func (c *bridgeCoalescer) do(ctx context.Context, key string, timeout time.Duration, fn func(context.Context) (any, error)) (v any, shared bool, err error) {
+	if c == nil {
+		v, err = fn(ctx)
+		return v, false, err
+	}
+	return doShared(ctx, &c.group, key, timeout, fn)
+}
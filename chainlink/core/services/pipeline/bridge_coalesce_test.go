@@ -0,0 +1,31 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestBridgeCoalescer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil coalescer", func(t *testing.T) {
+		var c *bridgeCoalescer
+		v, shared, err := c.do(testutils.Context(t), "a", time.Minute, func(context.Context) (any, error) { return "response", nil })
+		assert.NoError(t, err)
+		assert.False(t, shared)
+		assert.Equal(t, "response", v)
+	})
+
+	t.Run("coalesce key", func(t *testing.T) {
+		k := bridgeCoalesceKey("bridge", nil, []byte(`{"a":1}`))
+		assert.Equal(t, k, bridgeCoalesceKey("bridge", nil, []byte(`{"a":1}`)))
+		assert.NotEqual(t, k, bridgeCoalesceKey("other", nil, []byte(`{"a":1}`)))
+		assert.NotEqual(t, k, bridgeCoalesceKey("bridge", []string{"X-Foo", "bar"}, []byte(`{"a":1}`)))
+		assert.NotEqual(t, k, bridgeCoalesceKey("bridge", nil, []byte(`{"a":2}`)))
+	})
+}
@@ -0,0 +1,180 @@
+package pipeline
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrCircuitOpen is returned by http and bridge tasks when the circuit breaker
+// for their data provider is open, and the request was not attempted.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+type CircuitState string
+
+const (
+	CircuitStateClosed   CircuitState = "closed"
+	CircuitStateHalfOpen CircuitState = "half-open"
+	CircuitStateOpen     CircuitState = "open"
+)
+
+func (s CircuitState) gaugeValue() float64 {
+	switch s {
+	case CircuitStateHalfOpen:
+		return 1
+	case CircuitStateOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+var (
+	promCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_circuit_breaker_state",
+		Help: "State of the circuit breaker for a data provider (0 = closed, 1 = half-open, 2 = open)",
+	},
+		[]string{"key"},
+	)
+	promCircuitBreakerShortCircuits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_circuit_breaker_short_circuits_total",
+		Help: "Number of task runs which were not attempted because the circuit breaker for their data provider was open",
+	},
+		[]string{"key"},
+	)
+)
+
+// circuitBreakers tracks consecutive failures per data provider (bridge name
+// or URL host) across all runs of all jobs. After failureThreshold
+// consecutive failures the circuit opens and tasks fail fast with
+// ErrCircuitOpen. After resetTimeout the circuit half-opens and lets a single
+// trial request through: success closes the circuit, failure re-opens it.
+//
+// A nil *circuitBreakers is valid and never short-circuits.
+type circuitBreakers struct {
+	failureThreshold uint32
+	resetTimeout     time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+type circuitBreaker struct {
+	state         CircuitState
+	failures      uint32
+	openedAt      time.Time
+	trialInFlight bool
+}
+
+func newCircuitBreakers(cfg Config) *circuitBreakers {
+	if !cfg.CircuitBreakerEnabled() {
+		return nil
+	}
+	return &circuitBreakers{
+		failureThreshold: max(cfg.CircuitBreakerFailureThreshold(), 1),
+		resetTimeout:     cfg.CircuitBreakerResetTimeout(),
+		now:              time.Now,
+		breakers:         make(map[string]*circuitBreaker),
+	}
+}
+
+func bridgeCircuitKey(name string) string { return "bridge:" + name }
+
+func httpCircuitKey(host string) string { return "http:" + host }
+
+// allow returns ErrCircuitOpen if a request to key must not be attempted.
+// Every allowed request must be followed by a call to record.
+func (c *circuitBreakers) allow(key string) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[key]
+	if !ok {
+		return nil
+	}
+	c.refresh(key, b)
+	switch b.state {
+	case CircuitStateOpen:
+	case CircuitStateHalfOpen:
+		if !b.trialInFlight {
+			b.trialInFlight = true
+			return nil
+		}
+	default:
+		return nil
+	}
+	promCircuitBreakerShortCircuits.WithLabelValues(key).Inc()
+	return errors.Wrapf(ErrCircuitOpen, "%d consecutive failures for %s, retrying after %s", b.failures, key, b.openedAt.Add(c.resetTimeout).Format(time.RFC3339))
+}
+
+// record reports the outcome of an allowed request to key.
+func (c *circuitBreakers) record(key string, failed bool) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[key]
+	if !ok {
+		if !failed {
+			return
+		}
+		b = &circuitBreaker{state: CircuitStateClosed}
+		c.breakers[key] = b
+	}
+	b.trialInFlight = false
+
+	if !failed {
+		delete(c.breakers, key)
+		promCircuitBreakerState.WithLabelValues(key).Set(CircuitStateClosed.gaugeValue())
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitStateHalfOpen || b.failures >= c.failureThreshold {
+		b.state = CircuitStateOpen
+		b.openedAt = c.now()
+	}
+	promCircuitBreakerState.WithLabelValues(key).Set(b.state.gaugeValue())
+}
+
+// refresh half-opens b if it has been open for longer than resetTimeout.
+func (c *circuitBreakers) refresh(key string, b *circuitBreaker) {
+	if b.state == CircuitStateOpen && c.now().Sub(b.openedAt) >= c.resetTimeout {
+		b.state = CircuitStateHalfOpen
+		promCircuitBreakerState.WithLabelValues(key).Set(b.state.gaugeValue())
+	}
+}
+
+// States returns the state of every circuit which is not closed.
+func (c *circuitBreakers) States() map[string]CircuitState {
+	states := make(map[string]CircuitState)
+	if c == nil {
+		return states
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, b := range c.breakers {
+		c.refresh(key, b)
+		if b.state != CircuitStateClosed {
+			states[key] = b.state
+		}
+	}
+	return states
+}
+
+// healthReport adds an error under prefix for every open or half-open circuit.
+func (c *circuitBreakers) healthReport(prefix string, report map[string]error) {
+	for key, state := range c.States() {
+		report[fmt.Sprintf("%s.CircuitBreaker.%s", prefix, key)] = errors.Wrapf(ErrCircuitOpen, "state: %s", state)
+	}
+}
@@ -0,0 +1,94 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCircuitBreakers(threshold uint32, resetTimeout time.Duration) (*circuitBreakers, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	return &circuitBreakers{
+		failureThreshold: threshold,
+		resetTimeout:     resetTimeout,
+		now:              func() time.Time { return now },
+		breakers:         make(map[string]*circuitBreaker),
+	}, &now
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil breakers never short-circuit", func(t *testing.T) {
+		var c *circuitBreakers
+		require.NoError(t, c.allow("bridge:foo"))
+		c.record("bridge:foo", true)
+		assert.Empty(t, c.States())
+	})
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		c, _ := newTestCircuitBreakers(3, time.Minute)
+		key := bridgeCircuitKey("foo")
+
+		for range 2 {
+			require.NoError(t, c.allow(key))
+			c.record(key, true)
+		}
+		assert.Empty(t, c.States())
+
+		require.NoError(t, c.allow(key))
+		c.record(key, true)
+		assert.Equal(t, map[string]CircuitState{key: CircuitStateOpen}, c.States())
+		require.ErrorIs(t, c.allow(key), ErrCircuitOpen)
+
+		// other providers are unaffected
+		require.NoError(t, c.allow(httpCircuitKey("example.com")))
+	})
+
+	t.Run("success resets the failure count", func(t *testing.T) {
+		c, _ := newTestCircuitBreakers(2, time.Minute)
+		key := httpCircuitKey("example.com")
+
+		c.record(key, true)
+		c.record(key, false)
+		c.record(key, true)
+		require.NoError(t, c.allow(key))
+	})
+
+	t.Run("half-opens after the reset timeout", func(t *testing.T) {
+		c, now := newTestCircuitBreakers(1, time.Minute)
+		key := bridgeCircuitKey("foo")
+
+		c.record(key, true)
+		require.ErrorIs(t, c.allow(key), ErrCircuitOpen)
+
+		*now = now.Add(time.Minute)
+		assert.Equal(t, map[string]CircuitState{key: CircuitStateHalfOpen}, c.States())
+
+		// a single trial request is allowed
+		require.NoError(t, c.allow(key))
+		require.ErrorIs(t, c.allow(key), ErrCircuitOpen)
+
+		// a failed trial re-opens the circuit
+		c.record(key, true)
+		assert.Equal(t, map[string]CircuitState{key: CircuitStateOpen}, c.States())
+
+		*now = now.Add(time.Minute)
+		require.NoError(t, c.allow(key))
+		c.record(key, false)
+		assert.Empty(t, c.States())
+		require.NoError(t, c.allow(key))
+	})
+
+	t.Run("health report", func(t *testing.T) {
+		c, _ := newTestCircuitBreakers(1, time.Minute)
+		c.record(bridgeCircuitKey("foo"), true)
+
+		report := map[string]error{}
+		c.healthReport("PipelineRunner", report)
+		require.Len(t, report, 1)
+		require.ErrorIs(t, report["PipelineRunner.CircuitBreaker.bridge:foo"], ErrCircuitOpen)
+	})
+}
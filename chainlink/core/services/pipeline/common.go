@@ -38,6 +38,7 @@ const (
 	KeeperJobType                  string = "keeper"
 	LegacyGasStationServerJobType  string = "legacygasstationserver"
 	LegacyGasStationSidecarJobType string = "legacygasstationsidecar"
+	LogTriggerJobType              string = "logtrigger"
 	OffchainReporting2JobType      string = "offchainreporting2"
 	OffchainReportingJobType       string = "offchainreporting"
 	StreamJobType                  string = "stream"
@@ -67,6 +68,9 @@ type (
 	}
 
 	Config interface {
+		CircuitBreakerEnabled() bool
+		CircuitBreakerFailureThreshold() uint32
+		CircuitBreakerResetTimeout() time.Duration
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() commonconfig.Duration
 		MaxRunDuration() time.Duration
@@ -322,6 +326,8 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeForeach          TaskType = "foreach"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
@@ -337,11 +343,14 @@ const (
 	TaskTypeMin              TaskType = "min"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeOutlierFilter    TaskType = "outlierfilter"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -382,6 +391,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &MeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMedian:
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeOutlierFilter:
+		task = &OutlierFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMin:
 		task = &MinTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
@@ -446,6 +461,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeCoalesce:
 		task = &CoalesceTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForeach:
+		task = &ForeachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
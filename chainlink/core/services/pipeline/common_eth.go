@@ -103,6 +103,19 @@ func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Argumen
 	return name, args, indexedArgs, err
 }
 
+// ParseETHABIEventString parses an event signature with argument names, e.g.
+// "Transfer(address indexed from, address indexed to, uint256 value)".
+func ParseETHABIEventString(theABI []byte) (abi.Event, error) {
+	name, args, _, err := parseETHABIString(theABI, true)
+	if err != nil {
+		return abi.Event{}, err
+	}
+	if name == "" {
+		return abi.Event{}, errors.Errorf("bad ABI specification, missing event name: %s", theABI)
+	}
+	return abi.NewEvent(name, name, false, args), nil
+}
+
 func convertToETHABIType(val any, abiType abi.Type) (any, error) {
 	srcVal := reflect.ValueOf(val)
 
@@ -147,3 +147,19 @@ func Test_convertToETHABIBytes_Errors(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseETHABIEventString(t *testing.T) {
+	t.Parallel()
+
+	event, err := ParseETHABIEventString([]byte("Transfer(address indexed from, address indexed to, uint256 value)"))
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", event.Name)
+	assert.Equal(t, common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"), event.ID)
+	require.Len(t, event.Inputs, 3)
+	assert.Len(t, event.Inputs.NonIndexed(), 1)
+
+	_, err = ParseETHABIEventString([]byte("(address indexed from)"))
+	assert.ErrorContains(t, err, "missing event name")
+	_, err = ParseETHABIEventString([]byte("Transfer(address indexed)"))
+	assert.ErrorContains(t, err, "missing argument name")
+}
@@ -41,6 +41,7 @@ func makeHTTPRequest(
 		return
 	}
 	request.Header.Set("Content-Type", "application/json")
+	injectTraceHeaders(ctx, request.Header)
 	if len(reqHeaders)%2 != 0 {
 		panic("headers must have an even number of elements")
 	}
@@ -146,6 +146,11 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMax, &pipeline.MaxTask{}},
 		{pipeline.TaskTypeMean, &pipeline.MeanTask{}},
 		{pipeline.TaskTypeMedian, &pipeline.MedianTask{}},
+		{pipeline.TaskTypeWeightedMedian, &pipeline.WeightedMedianTask{}},
+		{pipeline.TaskTypeTrimmedMean, &pipeline.TrimmedMeanTask{}},
+		{pipeline.TaskTypeOutlierFilter, &pipeline.OutlierFilterTask{}},
+		{pipeline.TaskTypeForeach, &pipeline.ForeachTask{}},
+		{pipeline.TaskTypeExpr, &pipeline.ExprTask{}},
 		{pipeline.TaskTypeMin, &pipeline.MinTask{}},
 		{pipeline.TaskTypeMode, &pipeline.ModeTask{}},
 		{pipeline.TaskTypeSum, &pipeline.SumTask{}},
@@ -0,0 +1,844 @@
+package pipeline
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Expressions are evaluated by the expr task and the conditional task's expr
+// param. The language has no loops, assignments or user defined functions, so
+// evaluation is bounded by the size of the expression; the limits below bound
+// the size of the expression and of the values it produces. Numbers with more
+// than maxExpressionPrecision decimal places are rounded, and numbers with more
+// than maxExpressionIntegerDigits digits before the decimal point or more than
+// maxExpressionScale decimal places are an error, since rounding them is costly.
+const (
+	maxExpressionLength        = 4096
+	maxExpressionDepth         = 64
+	maxExpressionStringLength  = 1 << 20
+	maxExpressionPowExponent   = 256
+	maxExpressionIntegerDigits = 256
+	maxExpressionPrecision     = 64
+	maxExpressionScale         = maxExpressionPrecision + maxExpressionLength
+)
+
+var ErrExpression = errors.New("invalid expression")
+
+// exprNode is a node of a parsed expression.
+type exprNode interface {
+	eval(ctx context.Context, vars Vars) (any, error)
+}
+
+// parseExpression parses the given source into an expression tree.
+//
+// Numbers are decimals. Strings are quoted with ' or ". Variables are
+// referenced with $(keypath), and lists and maps can be indexed with [].
+// Supported operators, from lowest to highest precedence, are ?:, ||, &&,
+// == !=, < <= > >=, + -, * / %, and the unary ! and -. See exprFunctions for
+// the available functions.
+func parseExpression(source string) (exprNode, error) {
+	if len(source) > maxExpressionLength {
+		return nil, errors.Wrapf(ErrExpression, "longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	node, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, errors.Wrapf(ErrExpression, "unexpected %q at position %d", tok.text, tok.pos)
+	}
+	return node, nil
+}
+
+// evaluateExpression parses and evaluates the given source with vars.
+func evaluateExpression(ctx context.Context, source string, vars Vars) (any, error) {
+	node, err := parseExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	return node.eval(ctx, vars)
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenVariable
+	exprTokenIdent
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind  exprTokenKind
+	text  string
+	value any
+	pos   int
+}
+
+var exprOperators = []string{
+	"==", "!=", "<=", ">=", "&&", "||",
+	"<", ">", "+", "-", "*", "/", "%", "!", "?", ":", "(", ")", "[", "]", ",",
+}
+
+func lexExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	for pos := 0; pos < len(source); {
+		c := source[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+
+		case c == '$':
+			loc := variableRegexp.FindStringSubmatchIndex(source[pos:])
+			if loc == nil || loc[0] != 0 {
+				return nil, errors.Wrapf(ErrExpression, "invalid variable at position %d", pos)
+			}
+			tokens = append(tokens, exprToken{exprTokenVariable, source[pos : pos+loc[1]], source[pos+loc[2] : pos+loc[3]], pos})
+			pos += loc[1]
+
+		case c >= '0' && c <= '9' || c == '.' && pos+1 < len(source) && source[pos+1] >= '0' && source[pos+1] <= '9':
+			end := pos
+			for end < len(source) && (source[end] >= '0' && source[end] <= '9' || source[end] == '.') {
+				end++
+			}
+			if end < len(source) && (source[end] == 'e' || source[end] == 'E') {
+				end++
+				if end < len(source) && (source[end] == '+' || source[end] == '-') {
+					end++
+				}
+				for end < len(source) && source[end] >= '0' && source[end] <= '9' {
+					end++
+				}
+			}
+			d, err := decimal.NewFromString(source[pos:end])
+			if err != nil {
+				return nil, errors.Wrapf(ErrExpression, "invalid number %q at position %d", source[pos:end], pos)
+			}
+			if d, err = exprLimitDecimal(d); err != nil {
+				return nil, errors.Wrapf(err, "invalid number %q at position %d", source[pos:end], pos)
+			}
+			tokens = append(tokens, exprToken{exprTokenNumber, source[pos:end], d, pos})
+			pos = end
+
+		case c == '"' || c == '\'':
+			var sb strings.Builder
+			end := pos + 1
+			for ; end < len(source) && source[end] != c; end++ {
+				if source[end] != '\\' {
+					sb.WriteByte(source[end])
+					continue
+				}
+				end++
+				if end == len(source) {
+					break
+				}
+				switch source[end] {
+				case 'n':
+					sb.WriteByte('\n')
+				case 't':
+					sb.WriteByte('\t')
+				case '\\', '\'', '"':
+					sb.WriteByte(source[end])
+				default:
+					return nil, errors.Wrapf(ErrExpression, "invalid escape sequence at position %d", end-1)
+				}
+			}
+			if end >= len(source) {
+				return nil, errors.Wrapf(ErrExpression, "unterminated string at position %d", pos)
+			}
+			tokens = append(tokens, exprToken{exprTokenString, source[pos : end+1], sb.String(), pos})
+			pos = end + 1
+
+		case isExprIdentChar(c, false):
+			end := pos
+			for end < len(source) && isExprIdentChar(source[end], true) {
+				end++
+			}
+			tokens = append(tokens, exprToken{exprTokenIdent, source[pos:end], nil, pos})
+			pos = end
+
+		default:
+			var op string
+			for _, candidate := range exprOperators {
+				if strings.HasPrefix(source[pos:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Wrapf(ErrExpression, "unexpected character %q at position %d", c, pos)
+			}
+			tokens = append(tokens, exprToken{exprTokenOperator, op, nil, pos})
+			pos += len(op)
+		}
+	}
+	return append(tokens, exprToken{kind: exprTokenEOF, text: "end of expression", pos: len(source)}), nil
+}
+
+func isExprIdentChar(c byte, allowDigits bool) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || allowDigits && c >= '0' && c <= '9'
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	depth  int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		tok := p.peek()
+		return errors.Wrapf(ErrExpression, "expected %q at position %d, got %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+func (p *exprParser) parseTernary() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errors.Wrapf(ErrExpression, "nested deeper than %d levels", maxExpressionDepth)
+	}
+
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.accept("?"); !ok {
+		return cond, nil
+	}
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	els, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	return &exprTernary{cond, then, els}, nil
+}
+
+// exprBinaryPrecedence lists the binary operators from lowest to highest precedence.
+var exprBinaryPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprBinaryPrecedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(exprBinaryPrecedence[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{op, left, right}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	op, ok := p.accept("!", "-")
+	if !ok {
+		return p.parsePostfix()
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errors.Wrapf(ErrExpression, "nested deeper than %d levels", maxExpressionDepth)
+	}
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &exprUnary{op, operand}, nil
+}
+
+func (p *exprParser) parsePostfix() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("["); !ok {
+			return node, nil
+		}
+		index, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+		node = &exprIndex{node, index}
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenNumber, exprTokenString:
+		return &exprLiteral{tok.value}, nil
+
+	case exprTokenVariable:
+		return &exprVariable{tok.value.(string)}, nil
+
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return &exprLiteral{true}, nil
+		case "false":
+			return &exprLiteral{false}, nil
+		case "null":
+			return &exprLiteral{nil}, nil
+		}
+		fn, exists := exprFunctions[tok.text]
+		if !exists {
+			return nil, errors.Wrapf(ErrExpression, "unknown function %q at position %d", tok.text, tok.pos)
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		var args []exprNode
+		if _, ok := p.accept(")"); !ok {
+			for {
+				arg, err := p.parseTernary()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if _, ok := p.accept(","); !ok {
+					break
+				}
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+		}
+		if len(args) < fn.minArgs || fn.maxArgs >= 0 && len(args) > fn.maxArgs {
+			return nil, errors.Wrapf(ErrExpression, "wrong number of arguments for %s at position %d", tok.text, tok.pos)
+		}
+		return &exprCall{tok.text, fn, args}, nil
+
+	case exprTokenOperator:
+		if tok.text == "(" {
+			node, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrExpression, "unexpected %q at position %d", tok.text, tok.pos)
+}
+
+type exprLiteral struct {
+	value any
+}
+
+func (n *exprLiteral) eval(context.Context, Vars) (any, error) {
+	return n.value, nil
+}
+
+type exprVariable struct {
+	keypath string
+}
+
+func (n *exprVariable) eval(_ context.Context, vars Vars) (any, error) {
+	val, err := vars.Get(n.keypath)
+	if err != nil {
+		return nil, err
+	}
+	return exprValue(val), nil
+}
+
+type exprUnary struct {
+	op      string
+	operand exprNode
+}
+
+func (n *exprUnary) eval(ctx context.Context, vars Vars) (any, error) {
+	val, err := n.operand.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, err := exprBool(val)
+		return !b, err
+	}
+	d, err := exprDecimal(val)
+	if err != nil {
+		return nil, err
+	}
+	return d.Neg(), nil
+}
+
+type exprBinary struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *exprBinary) eval(ctx context.Context, vars Vars) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	left, err := n.left.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || only evaluate the right operand when needed
+	if n.op == "&&" || n.op == "||" {
+		l, err := exprBool(left)
+		if err != nil {
+			return nil, err
+		}
+		if l == (n.op == "||") {
+			return l, nil
+		}
+		right, err := n.right.eval(ctx, vars)
+		if err != nil {
+			return nil, err
+		}
+		return exprBool(right)
+	}
+
+	right, err := n.right.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return exprEqual(left, right), nil
+	case "!=":
+		return !exprEqual(left, right), nil
+	}
+
+	ls, lok := left.(string)
+	rs, rok := right.(string)
+	if lok && rok {
+		switch n.op {
+		case "+":
+			if len(ls)+len(rs) > maxExpressionStringLength {
+				return nil, errors.Wrapf(ErrExpression, "string longer than %d bytes", maxExpressionStringLength)
+			}
+			return ls + rs, nil
+		case "<":
+			return ls < rs, nil
+		case "<=":
+			return ls <= rs, nil
+		case ">":
+			return ls > rs, nil
+		case ">=":
+			return ls >= rs, nil
+		}
+	}
+
+	l, err := exprDecimal(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := exprDecimal(right)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "+":
+		return exprLimitDecimal(l.Add(r))
+	case "-":
+		return exprLimitDecimal(l.Sub(r))
+	case "*":
+		return exprLimitDecimal(l.Mul(r))
+	case "/", "%":
+		if r.IsZero() {
+			return nil, errors.Wrap(ErrDivideByZero, "expression")
+		}
+		if n.op == "/" {
+			return exprLimitDecimal(l.Div(r))
+		}
+		return exprLimitDecimal(l.Mod(r))
+	case "<":
+		return l.LessThan(r), nil
+	case "<=":
+		return l.LessThanOrEqual(r), nil
+	case ">":
+		return l.GreaterThan(r), nil
+	case ">=":
+		return l.GreaterThanOrEqual(r), nil
+	}
+	return nil, errors.Wrapf(ErrExpression, "unknown operator %q", n.op)
+}
+
+type exprTernary struct {
+	cond, then, els exprNode
+}
+
+func (n *exprTernary) eval(ctx context.Context, vars Vars) (any, error) {
+	val, err := n.cond.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := exprBool(val)
+	if err != nil {
+		return nil, err
+	}
+	if cond {
+		return n.then.eval(ctx, vars)
+	}
+	return n.els.eval(ctx, vars)
+}
+
+type exprIndex struct {
+	target, index exprNode
+}
+
+func (n *exprIndex) eval(ctx context.Context, vars Vars) (any, error) {
+	target, err := n.target.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	switch t := target.(type) {
+	case []any:
+		d, err := exprDecimal(index)
+		if err != nil {
+			return nil, err
+		}
+		if !d.IsInteger() || d.IsNegative() || d.GreaterThanOrEqual(decimal.NewFromInt(int64(len(t)))) {
+			return nil, errors.Wrapf(ErrIndexOutOfRange, "index %v of list of length %d", d, len(t))
+		}
+		return exprValue(t[d.IntPart()]), nil
+	case map[string]any:
+		key, ok := index.(string)
+		if !ok {
+			return nil, errors.Wrapf(ErrExpression, "map key must be a string, got %T", index)
+		}
+		val, exists := t[key]
+		if !exists {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "key %v", key)
+		}
+		return exprValue(val), nil
+	}
+	return nil, errors.Wrapf(ErrExpression, "cannot index %T", target)
+}
+
+type exprCall struct {
+	name string
+	fn   exprFunction
+	args []exprNode
+}
+
+func (n *exprCall) eval(ctx context.Context, vars Vars) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	args := make([]any, len(n.args))
+	for i, arg := range n.args {
+		val, err := arg.eval(ctx, vars)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = val
+	}
+	val, err := n.fn.call(ctx, args)
+	return val, errors.Wrap(err, n.name)
+}
+
+type exprFunction struct {
+	minArgs int
+	maxArgs int // -1 for any number of arguments
+	call    func(ctx context.Context, args []any) (any, error)
+}
+
+var exprFunctions = map[string]exprFunction{
+	"abs":   {1, 1, exprDecimalFunc(decimal.Decimal.Abs)},
+	"ceil":  {1, 1, exprDecimalFunc(decimal.Decimal.Ceil)},
+	"floor": {1, 1, exprDecimalFunc(decimal.Decimal.Floor)},
+	"round": {1, 2, func(_ context.Context, args []any) (any, error) {
+		d, err := exprDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		var places int64
+		if len(args) == 2 {
+			if places, err = exprInt(args[1]); err != nil {
+				return nil, err
+			}
+		}
+		if places < -maxExpressionIntegerDigits || places > maxExpressionPrecision {
+			return nil, errors.Wrapf(ErrExpression, "places must be between %d and %d", -maxExpressionIntegerDigits, maxExpressionPrecision)
+		}
+		return d.Round(int32(places)), nil
+	}},
+	"pow": {2, 2, func(_ context.Context, args []any) (any, error) {
+		d, err := exprDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		exp, err := exprInt(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if exp > maxExpressionPowExponent || exp < -maxExpressionPowExponent {
+			return nil, errors.Wrapf(ErrExpression, "exponent must be between %d and %d", -maxExpressionPowExponent, maxExpressionPowExponent)
+		}
+		if d.IsZero() && exp < 0 {
+			return nil, ErrDivideByZero
+		}
+		// reject results that are too large before computing them
+		if integerDigits := int64(d.NumDigits()) + int64(d.Exponent()); integerDigits > 1 && (integerDigits-1)*exp > maxExpressionIntegerDigits {
+			return nil, errors.Wrapf(ErrExpression, "result has more than %d integer digits", maxExpressionIntegerDigits)
+		}
+		return exprLimitDecimal(d.Pow(decimal.NewFromInt(exp)))
+	}},
+	"min": {1, -1, exprMinMax(-1)},
+	"max": {1, -1, exprMinMax(1)},
+	"len": {1, 1, func(_ context.Context, args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return decimal.NewFromInt(int64(utf8.RuneCountInString(v))), nil
+		case []any:
+			return decimal.NewFromInt(int64(len(v))), nil
+		case map[string]any:
+			return decimal.NewFromInt(int64(len(v))), nil
+		}
+		return nil, errors.Wrapf(ErrExpression, "cannot take length of %T", args[0])
+	}},
+	"lower": {1, 1, exprStringFunc(strings.ToLower)},
+	"upper": {1, 1, exprStringFunc(strings.ToUpper)},
+	"trim":  {1, 1, exprStringFunc(strings.TrimSpace)},
+	"contains": {2, 2, func(ctx context.Context, args []any) (any, error) {
+		if list, ok := args[0].([]any); ok {
+			for _, elem := range list {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				if exprEqual(exprValue(elem), args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return exprStringPredicate(strings.Contains, args)
+	}},
+	"startsWith": {2, 2, func(_ context.Context, args []any) (any, error) { return exprStringPredicate(strings.HasPrefix, args) }},
+	"endsWith":   {2, 2, func(_ context.Context, args []any) (any, error) { return exprStringPredicate(strings.HasSuffix, args) }},
+	"string": {1, 1, func(_ context.Context, args []any) (any, error) {
+		return exprString(args[0])
+	}},
+	"decimal": {1, 1, func(_ context.Context, args []any) (any, error) {
+		return exprDecimal(args[0])
+	}},
+}
+
+func exprDecimalFunc(f func(decimal.Decimal) decimal.Decimal) func(ctx context.Context, args []any) (any, error) {
+	return func(_ context.Context, args []any) (any, error) {
+		d, err := exprDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return f(d), nil
+	}
+}
+
+func exprStringFunc(f func(string) string) func(ctx context.Context, args []any) (any, error) {
+	return func(_ context.Context, args []any) (any, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, errors.Wrapf(ErrExpression, "expected a string, got %T", args[0])
+		}
+		return f(s), nil
+	}
+}
+
+func exprStringPredicate(f func(s, substr string) bool, args []any) (any, error) {
+	s, ok := args[0].(string)
+	if !ok {
+		return nil, errors.Wrapf(ErrExpression, "expected a string, got %T", args[0])
+	}
+	substr, ok := args[1].(string)
+	if !ok {
+		return nil, errors.Wrapf(ErrExpression, "expected a string, got %T", args[1])
+	}
+	return f(s, substr), nil
+}
+
+// exprMinMax returns the minimum (sign -1) or maximum (sign 1) of its
+// arguments, or of the elements of its only argument if that is a list.
+func exprMinMax(sign int) func(ctx context.Context, args []any) (any, error) {
+	return func(ctx context.Context, args []any) (any, error) {
+		if len(args) == 1 {
+			if list, ok := args[0].([]any); ok {
+				if len(list) == 0 {
+					return nil, errors.Wrap(ErrExpression, "empty list")
+				}
+				args = list
+			}
+		}
+		var result decimal.Decimal
+		for i, arg := range args {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			d, err := exprDecimal(exprValue(arg))
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 || d.Cmp(result) == sign {
+				result = d
+			}
+		}
+		return result, nil
+	}
+}
+
+// exprValue converts numbers of any type to decimals, so that values from
+// vars can be compared to literals.
+func exprValue(val any) any {
+	switch val.(type) {
+	case nil, string, bool, []any, map[string]any, decimal.Decimal:
+		return val
+	}
+	var d DecimalParam
+	if err := d.UnmarshalPipelineParam(val); err != nil {
+		return val
+	}
+	return d.Decimal()
+}
+
+func exprDecimal(val any) (decimal.Decimal, error) {
+	if _, ok := val.(bool); ok {
+		return decimal.Decimal{}, errors.Wrap(ErrExpression, "expected a number, got bool")
+	}
+	var d DecimalParam
+	if err := d.UnmarshalPipelineParam(val); err != nil {
+		return decimal.Decimal{}, errors.Wrapf(ErrExpression, "expected a number, got %T", val)
+	}
+	return exprLimitDecimal(d.Decimal())
+}
+
+// exprLimitDecimal rounds d to maxExpressionPrecision decimal places, and
+// rejects it if it has more than maxExpressionIntegerDigits integer digits or
+// more than maxExpressionScale decimal places.
+func exprLimitDecimal(d decimal.Decimal) (decimal.Decimal, error) {
+	if d.Exponent() < -maxExpressionScale {
+		return decimal.Decimal{}, errors.Wrapf(ErrExpression, "number has more than %d decimal places", maxExpressionScale)
+	}
+	if d.Exponent() < -maxExpressionPrecision {
+		d = d.Round(maxExpressionPrecision)
+	}
+	if int64(d.NumDigits())+int64(d.Exponent()) > maxExpressionIntegerDigits {
+		return decimal.Decimal{}, errors.Wrapf(ErrExpression, "number has more than %d integer digits", maxExpressionIntegerDigits)
+	}
+	return d, nil
+}
+
+func exprInt(val any) (int64, error) {
+	d, err := exprDecimal(val)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsInteger() || d.Abs().GreaterThan(decimal.NewFromInt32(1<<30)) {
+		return 0, errors.Wrapf(ErrExpression, "expected an integer, got %v", d)
+	}
+	return d.IntPart(), nil
+}
+
+func exprBool(val any) (bool, error) {
+	b, ok := val.(bool)
+	if !ok {
+		return false, errors.Wrapf(ErrExpression, "expected a bool, got %T", val)
+	}
+	return b, nil
+}
+
+func exprString(val any) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case decimal.Decimal:
+		return v.String(), nil
+	case nil:
+		return "null", nil
+	}
+	return "", errors.Wrapf(ErrExpression, "cannot convert %T to a string", val)
+}
+
+func exprEqual(left, right any) bool {
+	switch l := left.(type) {
+	case nil:
+		return right == nil
+	case string:
+		r, ok := right.(string)
+		return ok && l == r
+	case bool:
+		r, ok := right.(bool)
+		return ok && l == r
+	case decimal.Decimal:
+		r, ok := right.(decimal.Decimal)
+		return ok && l.Equal(r)
+	}
+	return reflect.DeepEqual(left, right)
+}
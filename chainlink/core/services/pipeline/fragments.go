@@ -0,0 +1,276 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TaskTypeFragment is not a task type, but marks a node which is replaced by
+// the tasks of a pipeline fragment when the pipeline is expanded.
+const TaskTypeFragment TaskType = "fragment"
+
+var fragmentNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Fragment is a named, reusable piece of pipeline DOT source. A pipeline
+// includes it with a node such as
+//
+//	prices [type=fragment name=eth_usd params=<{"pair": "ETH/USD"}>]
+//
+// Parse and ExpandFragments replace the node with the fragment's tasks, which are
+// marked with a fragment attribute holding the fragment's name. The source is a
+// text/template which is executed with params, e.g. {{ .pair }}. The tasks are
+// renamed with the including node's name as prefix, e.g. prices_fetch, except
+// the single terminal task which takes the including node's name, so that
+// $(prices) and edges from prices refer to the fragment's result. Edges to the
+// including node are connected to every task of the fragment without inputs.
+// Any other attributes of the including node, such as index, are set on the
+// terminal task.
+type Fragment struct {
+	Name      string
+	Source    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ValidateFragment checks the fragment's name, and that its source is a valid template.
+func ValidateFragment(f Fragment) error {
+	if !fragmentNameRegexp.MatchString(f.Name) {
+		return errors.Errorf("fragment name %q contains invalid characters", f.Name)
+	}
+	if strings.TrimSpace(f.Source) == "" {
+		return errors.New("fragment source must not be empty")
+	}
+	if _, err := template.New(f.Name).Parse(f.Source); err != nil {
+		return errors.Wrap(err, "fragment source is not a valid template")
+	}
+	return nil
+}
+
+// fragmentAttr is set on the tasks of an expanded fragment to the fragment's name.
+const fragmentAttr = "fragment"
+
+// fragmentLookupTimeout bounds each lookup of a FragmentResolver returned by NewFragmentResolver.
+const fragmentLookupTimeout = 5 * time.Second
+
+// FragmentResolver returns the source of the named fragment.
+type FragmentResolver func(name string) (string, error)
+
+var fragmentResolver atomic.Pointer[FragmentResolver]
+
+// SetFragmentResolver sets the FragmentResolver which Parse expands the fragments
+// included by a pipeline with. It is process wide: the application sets one
+// which looks fragments up in its database. Until one is set, Parse rejects
+// pipelines which include fragments.
+func SetFragmentResolver(resolve FragmentResolver) {
+	fragmentResolver.Store(&resolve)
+}
+
+func loadFragmentResolver() FragmentResolver {
+	if resolve := fragmentResolver.Load(); resolve != nil {
+		return *resolve
+	}
+	return nil
+}
+
+// NewFragmentResolver returns a FragmentResolver which looks fragments up with orm.
+func NewFragmentResolver(ctx context.Context, orm ORM) FragmentResolver {
+	return func(name string) (string, error) {
+		ctx, cancel := context.WithTimeout(ctx, fragmentLookupTimeout)
+		defer cancel()
+		fragment, err := orm.FindFragment(ctx, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New("fragment not found")
+		} else if err != nil {
+			return "", err
+		}
+		return fragment.Source, nil
+	}
+}
+
+// ExpandFragments returns the DOT source with all fragment nodes replaced by
+// the tasks of the fragments returned by resolve. Sources without fragment
+// nodes are returned unchanged. Parse expands fragments itself, with the
+// FragmentResolver set with SetFragmentResolver, so this is only needed to
+// expand them with another resolver, or once for a source parsed several times.
+func ExpandFragments(source string, resolve FragmentResolver) (string, error) {
+	g := NewGraph()
+	if err := g.unmarshalDOT([]byte(source)); err != nil {
+		return "", err
+	}
+	includes := g.fragmentNodes()
+	if len(includes) == 0 {
+		return source, nil
+	}
+	if err := g.expandFragments(includes, resolve); err != nil {
+		return "", err
+	}
+	return g.marshalDOT(), nil
+}
+
+// Fragments returns the names of the fragments which were expanded into the pipeline.
+func (p *Pipeline) Fragments() []string {
+	if p.tree == nil {
+		return nil
+	}
+	names := make(map[string]struct{})
+	for nodesIter := p.tree.Nodes(); nodesIter.Next(); {
+		if name, ok := nodesIter.Node().(*GraphNode).attrs[fragmentAttr]; ok {
+			names[name] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(names))
+}
+
+func (g *Graph) fragmentNodes() []*GraphNode {
+	var includes []*GraphNode
+	for nodesIter := g.Nodes(); nodesIter.Next(); {
+		node := nodesIter.Node().(*GraphNode)
+		if TaskType(strings.ToLower(node.attrs["type"])) == TaskTypeFragment {
+			includes = append(includes, node)
+		}
+	}
+	return includes
+}
+
+func (g *Graph) expandFragments(includes []*GraphNode, resolve FragmentResolver) error {
+	for _, include := range includes {
+		if resolve == nil {
+			return errors.Errorf("fragment %q included as %s cannot be expanded, no fragment resolver is set", include.attrs["name"], include.dotID)
+		}
+		if err := g.expandFragment(include, resolve); err != nil {
+			return errors.Wrapf(err, "fragment %q included as %s", include.attrs["name"], include.dotID)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) expandFragment(include *GraphNode, resolve FragmentResolver) error {
+	source, err := resolve(include.attrs["name"])
+	if err != nil {
+		return err
+	}
+
+	params := map[string]any{}
+	if p := strings.TrimSpace(include.attrs["params"]); p != "" {
+		if err := json.Unmarshal([]byte(p), &params); err != nil {
+			return errors.Wrap(err, "params must be a JSON object")
+		}
+	}
+	tmpl, err := template.New(include.attrs["name"]).Option("missingkey=error").Parse(source)
+	if err != nil {
+		return err
+	}
+	var rendered bytes.Buffer
+	if err = tmpl.Execute(&rendered, params); err != nil {
+		return err
+	}
+
+	sub := NewGraph()
+	if err = sub.unmarshalDOT(rendered.Bytes()); err != nil {
+		return err
+	}
+	sub.AddImplicitDependenciesAsEdges()
+
+	var terminal *GraphNode
+	renames := make(map[string]string)
+	for nodesIter := sub.Nodes(); nodesIter.Next(); {
+		node := nodesIter.Node().(*GraphNode)
+		if TaskType(strings.ToLower(node.attrs["type"])) == TaskTypeFragment {
+			return errors.New("fragments cannot include other fragments")
+		}
+		if sub.From(node.ID()).Len() == 0 {
+			if terminal != nil {
+				return errors.New("fragment must have exactly one terminal task")
+			}
+			terminal = node
+		}
+		renames[node.dotID] = fmt.Sprintf("%s_%s", include.dotID, node.dotID)
+	}
+	if terminal == nil {
+		return errors.New("fragment must have exactly one terminal task")
+	}
+	renames[terminal.dotID] = include.dotID
+	for nodesIter := g.Nodes(); nodesIter.Next(); {
+		node := nodesIter.Node().(*GraphNode)
+		for name, renamed := range renames {
+			if node != include && node.dotID == renamed {
+				return errors.Errorf("task %s of the fragment would be renamed to %s, which already exists", name, renamed)
+			}
+		}
+	}
+
+	// copy the fragment's tasks into the graph, renaming them and their references to each other
+	added := make(map[int64]*GraphNode)
+	for nodesIter := sub.Nodes(); nodesIter.Next(); {
+		node := nodesIter.Node().(*GraphNode)
+		newNode := g.NewNode().(*GraphNode)
+		newNode.dotID = renames[node.dotID]
+		newNode.attrs = make(map[string]string, len(node.attrs))
+		for k, v := range node.attrs {
+			newNode.attrs[k] = renameVariables(v, renames)
+		}
+		newNode.attrs[fragmentAttr] = include.attrs["name"]
+		g.AddNode(newNode)
+		added[node.ID()] = newNode
+	}
+	for k, v := range include.attrs {
+		if k != "type" && k != "name" && k != "params" {
+			added[terminal.ID()].attrs[k] = v
+		}
+	}
+	for edgesIter := sub.Edges(); edgesIter.Next(); {
+		edge := edgesIter.Edge().(*GraphEdge)
+		newEdge := g.NewEdge(added[edge.From().ID()], added[edge.To().ID()]).(*GraphEdge)
+		newEdge.SetIsImplicit(edge.IsImplicit())
+		g.SetEdge(newEdge)
+	}
+
+	// reconnect the including node's edges
+	for inputs := g.To(include.ID()); inputs.Next(); {
+		from := inputs.Node()
+		isImplicit := g.IsImplicitEdge(from.ID(), include.ID())
+		for id, node := range added {
+			if sub.To(id).Len() == 0 {
+				newEdge := g.NewEdge(from, node).(*GraphEdge)
+				newEdge.SetIsImplicit(isImplicit)
+				g.SetEdge(newEdge)
+			}
+		}
+	}
+	for outputs := g.From(include.ID()); outputs.Next(); {
+		to := outputs.Node()
+		newEdge := g.NewEdge(added[terminal.ID()], to).(*GraphEdge)
+		newEdge.SetIsImplicit(g.IsImplicitEdge(include.ID(), to.ID()))
+		g.SetEdge(newEdge)
+	}
+	g.RemoveNode(include.ID())
+	return nil
+}
+
+// renameVariables rewrites variable expressions whose first key is renamed.
+func renameVariables(value string, renames map[string]string) string {
+	return variableRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+		keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+		head, rest, _ := strings.Cut(keypath, ".")
+		renamed, ok := renames[head]
+		if !ok {
+			return expr
+		}
+		if rest != "" {
+			renamed += "." + rest
+		}
+		return "$(" + renamed + ")"
+	})
+}
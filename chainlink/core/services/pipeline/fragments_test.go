@@ -0,0 +1,210 @@
+package pipeline_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestValidateFragment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fragment pipeline.Fragment
+		wantErr  string
+	}{
+		{"valid", pipeline.Fragment{Name: "eth_usd-1", Source: `fetch [type=http url="{{ .url }}"]`}, ""},
+		{"invalid name", pipeline.Fragment{Name: "eth/usd", Source: `fetch [type=http]`}, "invalid characters"},
+		{"empty source", pipeline.Fragment{Name: "eth_usd", Source: " \n"}, "must not be empty"},
+		{"invalid template", pipeline.Fragment{Name: "eth_usd", Source: `fetch [type=http url="{{ .url "]`}, "not a valid template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pipeline.ValidateFragment(tt.fragment)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandFragments(t *testing.T) {
+	t.Parallel()
+
+	sources := map[string]string{
+		"test_fetch_parse": `
+			fetch [type=http method=GET url="{{ .url }}"];
+			parse [type=jsonparse path="data,price" data="$(fetch)"];
+			fetch -> parse;
+		`,
+		"test_two_terminals": `
+			a [type=memo value=1];
+			b [type=memo value=2];
+		`,
+		"test_nested": `
+			a [type=fragment name=test_fetch_parse];
+		`,
+	}
+	resolve := func(name string) (string, error) {
+		source, ok := sources[name]
+		if !ok {
+			return "", errors.New("fragment not found")
+		}
+		return source, nil
+	}
+	expandAndParse := func(t *testing.T, source string) *pipeline.Pipeline {
+		expanded, err := pipeline.ExpandFragments(source, resolve)
+		require.NoError(t, err)
+		p, err := pipeline.Parse(expanded)
+		require.NoError(t, err)
+		return p
+	}
+
+	t.Run("expands fragment", func(t *testing.T) {
+		p := expandAndParse(t, `
+			ds1 [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link/eth_usd"}> index=0];
+			ds2 [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link/btc_usd"}>];
+			answer [type=median values=<[ $(ds1), $(ds2) ]> index=1];
+			ds1 -> answer;
+		`)
+
+		tasks := make(map[string]pipeline.Task)
+		for _, task := range p.Tasks {
+			tasks[task.DotID()] = task
+		}
+		require.Len(t, tasks, 5)
+
+		fetch := tasks["ds1_fetch"].(*pipeline.HTTPTask)
+		assert.Equal(t, "https://chain.link/eth_usd", fetch.URL)
+		parse := tasks["ds1"].(*pipeline.JSONParseTask)
+		assert.Equal(t, "$(ds1_fetch)", parse.Data)
+		assert.Equal(t, int32(0), parse.OutputIndex())
+		require.Len(t, parse.Inputs(), 1)
+		assert.Equal(t, "ds1_fetch", parse.Inputs()[0].InputTask.DotID())
+		assert.True(t, parse.Inputs()[0].PropagateResult)
+
+		fetch = tasks["ds2_fetch"].(*pipeline.HTTPTask)
+		assert.Equal(t, "https://chain.link/btc_usd", fetch.URL)
+		assert.Equal(t, "$(ds2_fetch)", tasks["ds2"].(*pipeline.JSONParseTask).Data)
+
+		answer := tasks["answer"]
+		var inputs []string
+		for _, input := range answer.Inputs() {
+			inputs = append(inputs, input.InputTask.DotID())
+		}
+		assert.ElementsMatch(t, []string{"ds1", "ds2"}, inputs)
+
+		assert.Equal(t, []string{"test_fetch_parse"}, p.Fragments())
+	})
+
+	t.Run("connects inputs to fragment sources", func(t *testing.T) {
+		p := expandAndParse(t, `
+			start [type=memo value="x"];
+			ds [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link"}>];
+			start -> ds;
+		`)
+		fetch := p.ByDotID("ds_fetch")
+		require.NotNil(t, fetch)
+		require.Len(t, fetch.Inputs(), 1)
+		assert.Equal(t, "start", fetch.Inputs()[0].InputTask.DotID())
+	})
+
+	t.Run("keeps attribute values", func(t *testing.T) {
+		tasks := `
+			a [type=memo value=<{"x": "<y>"}>];
+			b [type=memo value="say \"hi\" <b>"];
+			c [type=memo value=""];
+			d [type=memo value=<{"a": 1}>];
+			a -> b -> c -> d;
+		`
+		want, err := pipeline.Parse(tasks)
+		require.NoError(t, err)
+		p := expandAndParse(t, tasks+`
+			ds [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link?a=1&b=2"}>];
+			d -> ds;
+		`)
+		for _, id := range []string{"a", "b", "c", "d"} {
+			assert.Equal(t, want.ByDotID(id).(*pipeline.MemoTask).Value, p.ByDotID(id).(*pipeline.MemoTask).Value)
+		}
+		assert.Equal(t, `say "hi" <b>`, p.ByDotID("b").(*pipeline.MemoTask).Value)
+		assert.Equal(t, "https://chain.link?a=1&b=2", p.ByDotID("ds_fetch").(*pipeline.HTTPTask).URL)
+	})
+
+	t.Run("leaves pipelines without fragments unchanged", func(t *testing.T) {
+		source := `a [type=memo value=1]; // a comment`
+		expanded, err := pipeline.ExpandFragments(source, resolve)
+		require.NoError(t, err)
+		assert.Equal(t, source, expanded)
+
+		p, err := pipeline.Parse(source)
+		require.NoError(t, err)
+		assert.Empty(t, p.Fragments())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			spec    string
+			wantErr string
+		}{
+			{"unknown fragment", `ds [type=fragment name=test_missing]`, "fragment not found"},
+			{"missing param", `ds [type=fragment name=test_fetch_parse]`, "map has no entry for key"},
+			{"invalid params", `ds [type=fragment name=test_fetch_parse params="nope"]`, "params must be a JSON object"},
+			{"multiple terminal tasks", `ds [type=fragment name=test_two_terminals]`, "exactly one terminal task"},
+			{"nested fragments", `ds [type=fragment name=test_nested]`, "cannot include other fragments"},
+			{"name collision", `
+				ds_fetch [type=memo value=1];
+				ds [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link"}>];
+			`, "already exists"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := pipeline.ExpandFragments(tt.spec, resolve)
+				require.ErrorContains(t, err, tt.wantErr)
+			})
+		}
+	})
+}
+
+// not parallel, since the fragment resolver of Parse is process wide
+func TestParse_Fragments(t *testing.T) {
+	source := `
+		ds [type=fragment name=test_fetch_parse params=<{"url": "https://chain.link"}>];
+		answer [type=multiply input="$(ds)" times=100];
+	`
+
+	_, err := pipeline.Parse(source)
+	require.ErrorContains(t, err, "no fragment resolver is set")
+
+	t.Cleanup(func() { pipeline.SetFragmentResolver(nil) })
+	pipeline.SetFragmentResolver(func(name string) (string, error) {
+		if name != "test_fetch_parse" {
+			return "", errors.New("fragment not found")
+		}
+		return `
+			fetch [type=http method=GET url="{{ .url }}"];
+			parse [type=jsonparse path="data,price" data="$(fetch)"];
+			fetch -> parse;
+		`, nil
+	})
+	p, err := pipeline.Parse(source)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 3)
+	assert.Equal(t, "https://chain.link", p.ByDotID("ds_fetch").(*pipeline.HTTPTask).URL)
+	assert.Equal(t, []string{"test_fetch_parse"}, p.Fragments())
+
+	// the source of the pipeline is expanded, so that it parses the same without the fragments
+	pipeline.SetFragmentResolver(nil)
+	assert.NotContains(t, p.Source, "type=fragment")
+	reparsed, err := pipeline.Parse(p.Source)
+	require.NoError(t, err)
+	assert.Len(t, reparsed.Tasks, 3)
+	assert.Equal(t, p.Source, reparsed.Source)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,10 +34,30 @@ func (g *Graph) NewEdge(from, to graph.Node) graph.Edge {
 	return &GraphEdge{Edge: g.DirectedGraph.NewEdge(from, to)}
 }
 
+// UnmarshalText parses the DOT source of a pipeline, expanding the fragments it
+// includes with the FragmentResolver set with SetFragmentResolver.
 func (g *Graph) UnmarshalText(bs []byte) (err error) {
+	_, err = g.unmarshalText(bs)
+	return err
+}
+
+// unmarshalText is UnmarshalText, which also returns whether any fragment was expanded.
+func (g *Graph) unmarshalText(bs []byte) (expanded bool, err error) {
 	if g.DirectedGraph == nil {
 		g.DirectedGraph = simple.NewDirectedGraph()
 	}
+	if err = g.unmarshalDOT(bs); err != nil {
+		return false, err
+	}
+	includes := g.fragmentNodes()
+	if err = g.expandFragments(includes, loadFragmentResolver()); err != nil {
+		return false, err
+	}
+	g.AddImplicitDependenciesAsEdges()
+	return len(includes) > 0, nil
+}
+
+func (g *Graph) unmarshalDOT(bs []byte) (err error) {
 	defer func() {
 		if rerr := recover(); rerr != nil {
 			err = fmt.Errorf("could not unmarshal DOT into a pipeline.Graph: %v", rerr)
@@ -48,10 +69,68 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
 	}
-	g.AddImplicitDependenciesAsEdges()
 	return nil
 }
 
+// marshalDOT returns the graph as DOT statements which unmarshalDOT parses back
+// into the same nodes and explicit edges. Implicit edges are left out, since
+// they are added again when the pipeline is parsed.
+func (g *Graph) marshalDOT() string {
+	nodes := graph.NodesOf(g.Nodes())
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+
+	var b strings.Builder
+	for _, n := range nodes {
+		node := n.(*GraphNode)
+		b.WriteString(quoteDOTID(node.dotID))
+		b.WriteString(" [")
+		for i, attr := range node.Attributes() {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(quoteDOTID(attr.Key) + "=" + quoteDOTValue(attr.Value))
+		}
+		b.WriteString("];\n")
+	}
+	for _, n := range nodes {
+		outputs := graph.NodesOf(g.From(n.ID()))
+		sort.Slice(outputs, func(i, j int) bool { return outputs[i].ID() < outputs[j].ID() })
+		for _, to := range outputs {
+			if !g.IsImplicitEdge(n.ID(), to.ID()) {
+				b.WriteString(quoteDOTID(n.(*GraphNode).dotID) + " -> " + quoteDOTID(to.(*GraphNode).dotID) + ";\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+var dotIDRegexp = regexp.MustCompile(`\A[a-zA-Z_][a-zA-Z0-9_]*\z`)
+
+func quoteDOTID(id string) string {
+	if dotIDRegexp.MatchString(id) {
+		return id
+	}
+	return strconv.Quote(id)
+}
+
+// quoteDOTValue quotes an attribute value so that SetAttribute receives it
+// unchanged: in angle brackets if it has none itself, and otherwise as a quoted
+// string, escaping a leading angle bracket which would stop it being unquoted.
+func quoteDOTValue(value string) string {
+	switch {
+	case value == "":
+		return `""`
+	case !strings.ContainsAny(value, "<>"):
+		return "<" + value + ">"
+	default:
+		quoted := strconv.Quote(value)
+		if strings.HasPrefix(quoted, `"<`) {
+			quoted = `"\x3c` + quoted[2:]
+		}
+		return quoted
+	}
+}
+
 // Looks at node attributes and searches for implicit dependencies on other nodes
 // expressed as attribute values. Adds those dependencies as implicit edges in the graph.
 func (g *Graph) AddImplicitDependenciesAsEdges() {
@@ -213,11 +292,16 @@ func Parse(text string) (*Pipeline, error) {
 		return nil, errors.New("empty pipeline")
 	}
 	g := NewGraph()
-	err := g.UnmarshalText([]byte(text))
+	expanded, err := g.unmarshalText([]byte(text))
 
 	if err != nil {
 		return nil, err
 	}
+	if expanded {
+		// the source of the pipeline is the expanded one, so that it is saved
+		// and run as it is now, even if the fragments change later
+		text = g.marshalDOT()
+	}
 
 	p := &Pipeline{
 		tree:   g,
@@ -253,6 +337,18 @@ func Parse(text string) (*Pipeline, error) {
 			return nil, err
 		}
 
+		switch t := task.(type) {
+		case *ForeachTask:
+			err = t.parseBody()
+		case *ExprTask:
+			err = t.compile()
+		case *ConditionalTask:
+			err = t.compile()
+		}
+		if err != nil {
+			return nil, err
+		}
+
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
 			if exists {
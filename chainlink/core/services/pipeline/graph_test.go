@@ -259,6 +259,13 @@ func TestParse(t *testing.T) {
 		{"empty", ""},
 		{"blank", " "},
 		{"foo", "foo"},
+		{"foreach without body", `a [type=foreach values="[1]"]`},
+		{"foreach with invalid body", `a [type=foreach values="[1]" body="foo"]`},
+		{"foreach with two terminal tasks", `a [type=foreach values="[1]" body=<b [type=memo value=1]; c [type=memo value=2]>]`},
+		{"foreach with ethtx", `a [type=foreach values="[1]" body=<b [type=ethtx]>]`},
+		{"expr without expression", `a [type=expr]`},
+		{"expr with invalid expression", `a [type=expr expression="1 +"]`},
+		{"conditional with invalid expr", `a [type=conditional expr="foo(1)"]`},
 	} {
 		t.Run(s.name, func(t *testing.T) {
 			_, err := pipeline.Parse(s.pipeline)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -45,12 +46,29 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetCoalescer() {
+	t.coalescer = newBridgeCoalescer()
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCache(orm ORM) {
+	t.httpCache = newHTTPResponseCache(defaultHTTPCacheSize)
+	t.orm = orm
+}
+
+func (t *HTTPTask) HelperShareCache(other *HTTPTask) {
+	t.httpCache = other.httpCache
+}
+
+func (t *ForeachTask) HelperSetRunBody(runBody func(ctx context.Context, vars Vars) (Result, error)) {
+	t.runBody = runBody
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -65,4 +83,8 @@ func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCont
 	t.jobType = jobType
 }
 
+func (r *runner) HelperSetTracer(tracer trace.Tracer) {
+	r.tracer = tracer
+}
+
 func (o *orm) Prune(ctx context.Context, pipelineSpecID int32) { o.prune(ctx, o.ds, pipelineSpecID) }
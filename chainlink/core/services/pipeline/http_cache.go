@@ -0,0 +1,179 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultHTTPCacheSize bounds the number of responses held in memory. Each
+	// response is at most DefaultHTTPLimit bytes.
+	defaultHTTPCacheSize = 1000
+
+	// defaultSharedRequestTimeout bounds a coalesced request when neither the
+	// caller nor the config sets a timeout.
+	defaultSharedRequestTimeout = time.Minute
+)
+
+var (
+	promHTTPCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "Number of http task runs served from the in-memory response cache",
+	})
+	promHTTPCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses_total",
+		Help: "Number of http task runs with caching enabled which were not served from the in-memory response cache",
+	})
+	promHTTPCacheCoalesced = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_coalesced_total",
+		Help: "Number of http task runs which shared the response of a concurrent identical request",
+	})
+	promHTTPCacheFallbacks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_fallbacks_total",
+		Help: "Number of failed http task runs which fell back to the last response stored in the database",
+	})
+)
+
+// httpResponseCache is an in-memory LRU of successful http task responses,
+// shared by all the jobs of the node, see httpCacheKey. Concurrent fetches for
+// the same key are coalesced into a single request.
+//
+// A nil *httpResponseCache is valid and caches nothing.
+type httpResponseCache struct {
+	size int
+	now  func() time.Time
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+
+	group singleflight.Group
+}
+
+type httpCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func newHTTPResponseCache(size int) *httpResponseCache {
+	return &httpResponseCache{
+		size:  size,
+		now:   time.Now,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// httpCacheKey derives a cache key from everything which identifies a request,
+// so that identical requests of different jobs share a response. It includes
+// the network access of the task, so that a restricted task is never served a
+// response fetched without the restrictions.
+func httpCacheKey(unrestricted bool, method string, url string, reqHeaders []string, requestDataJSON []byte) string {
+	return hashCacheKey(unrestricted, "request", append([]string{method, url}, reqHeaders...), requestDataJSON)
+}
+
+// httpCustomCacheKey derives a cache key from a cacheKey set on the task like
+// httpCacheKey. Tasks setting the same cacheKey share a response.
+func httpCustomCacheKey(unrestricted bool, cacheKey string) string {
+	return hashCacheKey(unrestricted, "custom", []string{cacheKey}, nil)
+}
+
+func hashCacheKey(unrestricted bool, kind string, parts []string, data []byte) string {
+	h := sha256.New()
+	for _, part := range append([]string{strconv.FormatBool(unrestricted), kind}, parts...) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *httpResponseCache) get(key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*httpCacheEntry)
+	if c.now().After(entry.expiresAt) {
+		c.ll.Remove(elem)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *httpResponseCache) put(key string, value []byte, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*httpCacheEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.ll.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&httpCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*httpCacheEntry).key)
+	}
+}
+
+// do executes fn once for all concurrent callers with the same key, see
+// doShared.
+func (c *httpResponseCache) do(ctx context.Context, key string, timeout time.Duration, fn func(context.Context) (any, error)) (v any, shared bool, err error) {
+	if c == nil {
+		v, err = fn(ctx)
+		return v, false, err
+	}
+	return doShared(ctx, &c.group, key, timeout, fn)
+}
+
+// doShared executes fn once for all concurrent callers of group with the same
+// key. fn runs on a context detached from the cancellation of ctx, so that the
+// caller which started the request giving up does not fail the others, with
+// its own timeout instead: the remaining time of ctx if longer than timeout,
+// or defaultSharedRequestTimeout if neither is set. Each caller stops waiting
+// when its own ctx is done. shared is true if the result was handed to more
+// than one caller.
+func doShared(ctx context.Context, group *singleflight.Group, key string, timeout time.Duration, fn func(context.Context) (any, error)) (v any, shared bool, err error) {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) > timeout {
+		timeout = time.Until(deadline)
+	}
+	if timeout <= 0 {
+		timeout = defaultSharedRequestTimeout
+	}
+	ch := group.DoChan(key, func() (any, error) {
+		sharedCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		return fn(sharedCtx)
+	})
+	select {
+	case res := <-ch:
+		return res.Val, res.Shared, res.Err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
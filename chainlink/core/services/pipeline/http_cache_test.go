@@ -0,0 +1,127 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestHTTPResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("expires entries after their ttl", func(t *testing.T) {
+		c := newHTTPResponseCache(10)
+		now := time.Unix(1700000000, 0)
+		c.now = func() time.Time { return now }
+
+		c.put("a", []byte("1"), time.Minute)
+		v, ok := c.get("a")
+		require.True(t, ok)
+		assert.Equal(t, []byte("1"), v)
+
+		now = now.Add(time.Minute + time.Second)
+		_, ok = c.get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		c := newHTTPResponseCache(2)
+		c.put("a", []byte("1"), time.Minute)
+		c.put("b", []byte("2"), time.Minute)
+		_, _ = c.get("a")
+		c.put("c", []byte("3"), time.Minute)
+
+		_, ok := c.get("b")
+		assert.False(t, ok)
+		_, ok = c.get("a")
+		assert.True(t, ok)
+		_, ok = c.get("c")
+		assert.True(t, ok)
+	})
+
+	t.Run("coalesces concurrent requests", func(t *testing.T) {
+		c := newHTTPResponseCache(10)
+		var calls atomic.Int32
+		release := make(chan struct{})
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, _, err := c.do(testutils.Context(t), "a", time.Minute, func(context.Context) (any, error) {
+					calls.Add(1)
+					<-release
+					return "response", nil
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, "response", v)
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("a waiter giving up does not cancel the shared request", func(t *testing.T) {
+		c := newHTTPResponseCache(10)
+		started, release := make(chan struct{}), make(chan struct{})
+		fetchErr := make(chan error, 1)
+
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		go func() {
+			_, _, err := c.do(ctx, "a", time.Minute, func(fetchCtx context.Context) (any, error) {
+				close(started)
+				<-release
+				fetchErr <- fetchCtx.Err()
+				return "response", nil
+			})
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+		<-started
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			v, shared, err := c.do(testutils.Context(t), "a", time.Minute, func(context.Context) (any, error) {
+				return "other", nil
+			})
+			assert.NoError(t, err)
+			assert.True(t, shared)
+			assert.Equal(t, "response", v)
+		}()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		<-done
+		assert.NoError(t, <-fetchErr)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		var c *httpResponseCache
+		c.put("a", []byte("1"), time.Minute)
+		_, ok := c.get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("cache key", func(t *testing.T) {
+		k := httpCacheKey(false, "GET", "https://example.com", nil, []byte(`{}`))
+		assert.Equal(t, k, httpCacheKey(false, "GET", "https://example.com", nil, []byte(`{}`)))
+		assert.NotEqual(t, k, httpCacheKey(false, "POST", "https://example.com", nil, []byte(`{}`)))
+		assert.NotEqual(t, k, httpCacheKey(false, "GET", "https://example.com", []string{"X-Foo", "bar"}, []byte(`{}`)))
+		assert.NotEqual(t, k, httpCacheKey(true, "GET", "https://example.com", nil, []byte(`{}`)), "must be scoped to the network access")
+
+		custom := httpCustomCacheKey(false, "price")
+		assert.Equal(t, custom, httpCustomCacheKey(false, "price"))
+		assert.NotEqual(t, custom, httpCustomCacheKey(true, "price"))
+		assert.NotEqual(t, custom, k)
+	})
+}
@@ -22,6 +22,141 @@ func (_m *Config) EXPECT() *Config_Expecter {
 	return &Config_Expecter{mock: &_m.Mock}
 }
 
+// CircuitBreakerEnabled provides a mock function with no fields
+func (_m *Config) CircuitBreakerEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Config_CircuitBreakerEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CircuitBreakerEnabled'
+type Config_CircuitBreakerEnabled_Call struct {
+	*mock.Call
+}
+
+// CircuitBreakerEnabled is a helper method to define mock.On call
+func (_e *Config_Expecter) CircuitBreakerEnabled() *Config_CircuitBreakerEnabled_Call {
+	return &Config_CircuitBreakerEnabled_Call{Call: _e.mock.On("CircuitBreakerEnabled")}
+}
+
+func (_c *Config_CircuitBreakerEnabled_Call) Run(run func()) *Config_CircuitBreakerEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_CircuitBreakerEnabled_Call) Return(_a0 bool) *Config_CircuitBreakerEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_CircuitBreakerEnabled_Call) RunAndReturn(run func() bool) *Config_CircuitBreakerEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CircuitBreakerFailureThreshold provides a mock function with no fields
+func (_m *Config) CircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerFailureThreshold")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_CircuitBreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CircuitBreakerFailureThreshold'
+type Config_CircuitBreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// CircuitBreakerFailureThreshold is a helper method to define mock.On call
+func (_e *Config_Expecter) CircuitBreakerFailureThreshold() *Config_CircuitBreakerFailureThreshold_Call {
+	return &Config_CircuitBreakerFailureThreshold_Call{Call: _e.mock.On("CircuitBreakerFailureThreshold")}
+}
+
+func (_c *Config_CircuitBreakerFailureThreshold_Call) Run(run func()) *Config_CircuitBreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_CircuitBreakerFailureThreshold_Call) Return(_a0 uint32) *Config_CircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_CircuitBreakerFailureThreshold_Call) RunAndReturn(run func() uint32) *Config_CircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CircuitBreakerResetTimeout provides a mock function with no fields
+func (_m *Config) CircuitBreakerResetTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerResetTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_CircuitBreakerResetTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CircuitBreakerResetTimeout'
+type Config_CircuitBreakerResetTimeout_Call struct {
+	*mock.Call
+}
+
+// CircuitBreakerResetTimeout is a helper method to define mock.On call
+func (_e *Config_Expecter) CircuitBreakerResetTimeout() *Config_CircuitBreakerResetTimeout_Call {
+	return &Config_CircuitBreakerResetTimeout_Call{Call: _e.mock.On("CircuitBreakerResetTimeout")}
+}
+
+func (_c *Config_CircuitBreakerResetTimeout_Call) Run(run func()) *Config_CircuitBreakerResetTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_CircuitBreakerResetTimeout_Call) Return(_a0 time.Duration) *Config_CircuitBreakerResetTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_CircuitBreakerResetTimeout_Call) RunAndReturn(run func() time.Duration) *Config_CircuitBreakerResetTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DefaultHTTPLimit provides a mock function with no fields
 func (_m *Config) DefaultHTTPLimit() int64 {
 	ret := _m.Called()
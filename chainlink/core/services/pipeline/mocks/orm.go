@@ -73,6 +73,53 @@ func (_c *ORM_Close_Call) RunAndReturn(run func() error) *ORM_Close_Call {
 	return _c
 }
 
+// CreateFragment provides a mock function with given fields: ctx, fragment
+func (_m *ORM) CreateFragment(ctx context.Context, fragment *pipeline.Fragment) error {
+	ret := _m.Called(ctx, fragment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFragment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pipeline.Fragment) error); ok {
+		r0 = rf(ctx, fragment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_CreateFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFragment'
+type ORM_CreateFragment_Call struct {
+	*mock.Call
+}
+
+// CreateFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fragment *pipeline.Fragment
+func (_e *ORM_Expecter) CreateFragment(ctx interface{}, fragment interface{}) *ORM_CreateFragment_Call {
+	return &ORM_CreateFragment_Call{Call: _e.mock.On("CreateFragment", ctx, fragment)}
+}
+
+func (_c *ORM_CreateFragment_Call) Run(run func(ctx context.Context, fragment *pipeline.Fragment)) *ORM_CreateFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pipeline.Fragment))
+	})
+	return _c
+}
+
+func (_c *ORM_CreateFragment_Call) Return(_a0 error) *ORM_CreateFragment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_CreateFragment_Call) RunAndReturn(run func(context.Context, *pipeline.Fragment) error) *ORM_CreateFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRun provides a mock function with given fields: ctx, run
 func (_m *ORM) CreateRun(ctx context.Context, run *pipeline.Run) error {
 	ret := _m.Called(ctx, run)
@@ -225,6 +272,53 @@ func (_c *ORM_DataSource_Call) RunAndReturn(run func() sqlutil.DataSource) *ORM_
 	return _c
 }
 
+// DeleteFragment provides a mock function with given fields: ctx, name
+func (_m *ORM) DeleteFragment(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFragment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_DeleteFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFragment'
+type ORM_DeleteFragment_Call struct {
+	*mock.Call
+}
+
+// DeleteFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) DeleteFragment(ctx interface{}, name interface{}) *ORM_DeleteFragment_Call {
+	return &ORM_DeleteFragment_Call{Call: _e.mock.On("DeleteFragment", ctx, name)}
+}
+
+func (_c *ORM_DeleteFragment_Call) Run(run func(ctx context.Context, name string)) *ORM_DeleteFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteFragment_Call) Return(_a0 error) *ORM_DeleteFragment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_DeleteFragment_Call) RunAndReturn(run func(context.Context, string) error) *ORM_DeleteFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRun provides a mock function with given fields: ctx, id
 func (_m *ORM) DeleteRun(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -319,6 +413,182 @@ func (_c *ORM_DeleteRunsOlderThan_Call) RunAndReturn(run func(context.Context, t
 	return _c
 }
 
+// FindFragment provides a mock function with given fields: ctx, name
+func (_m *ORM) FindFragment(ctx context.Context, name string) (pipeline.Fragment, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFragment")
+	}
+
+	var r0 pipeline.Fragment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (pipeline.Fragment, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) pipeline.Fragment); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(pipeline.Fragment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFragment'
+type ORM_FindFragment_Call struct {
+	*mock.Call
+}
+
+// FindFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) FindFragment(ctx interface{}, name interface{}) *ORM_FindFragment_Call {
+	return &ORM_FindFragment_Call{Call: _e.mock.On("FindFragment", ctx, name)}
+}
+
+func (_c *ORM_FindFragment_Call) Run(run func(ctx context.Context, name string)) *ORM_FindFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindFragment_Call) Return(_a0 pipeline.Fragment, _a1 error) *ORM_FindFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindFragment_Call) RunAndReturn(run func(context.Context, string) (pipeline.Fragment, error)) *ORM_FindFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindHTTPResponse provides a mock function with given fields: ctx, key, maxAge
+func (_m *ORM) FindHTTPResponse(ctx context.Context, key string, maxAge time.Duration) ([]byte, error) {
+	ret := _m.Called(ctx, key, maxAge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindHTTPResponse")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) ([]byte, error)); ok {
+		return rf(ctx, key, maxAge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) []byte); ok {
+		r0 = rf(ctx, key, maxAge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, key, maxAge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindHTTPResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindHTTPResponse'
+type ORM_FindHTTPResponse_Call struct {
+	*mock.Call
+}
+
+// FindHTTPResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - maxAge time.Duration
+func (_e *ORM_Expecter) FindHTTPResponse(ctx interface{}, key interface{}, maxAge interface{}) *ORM_FindHTTPResponse_Call {
+	return &ORM_FindHTTPResponse_Call{Call: _e.mock.On("FindHTTPResponse", ctx, key, maxAge)}
+}
+
+func (_c *ORM_FindHTTPResponse_Call) Run(run func(ctx context.Context, key string, maxAge time.Duration)) *ORM_FindHTTPResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_FindHTTPResponse_Call) Return(_a0 []byte, _a1 error) *ORM_FindHTTPResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindHTTPResponse_Call) RunAndReturn(run func(context.Context, string, time.Duration) ([]byte, error)) *ORM_FindHTTPResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindJobIDsWithFragment provides a mock function with given fields: ctx, name
+func (_m *ORM) FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobIDsWithFragment")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int32, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int32); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobIDsWithFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobIDsWithFragment'
+type ORM_FindJobIDsWithFragment_Call struct {
+	*mock.Call
+}
+
+// FindJobIDsWithFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) FindJobIDsWithFragment(ctx interface{}, name interface{}) *ORM_FindJobIDsWithFragment_Call {
+	return &ORM_FindJobIDsWithFragment_Call{Call: _e.mock.On("FindJobIDsWithFragment", ctx, name)}
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Run(run func(ctx context.Context, name string)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Return(_a0 []int32, _a1 error) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) RunAndReturn(run func(context.Context, string) ([]int32, error)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindRun provides a mock function with given fields: ctx, id
 func (_m *ORM) FindRun(ctx context.Context, id int64) (pipeline.Run, error) {
 	ret := _m.Called(ctx, id)
@@ -376,6 +646,73 @@ func (_c *ORM_FindRun_Call) RunAndReturn(run func(context.Context, int64) (pipel
 	return _c
 }
 
+// Fragments provides a mock function with given fields: ctx, offset, limit
+func (_m *ORM) Fragments(ctx context.Context, offset int, limit int) ([]pipeline.Fragment, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fragments")
+	}
+
+	var r0 []pipeline.Fragment
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]pipeline.Fragment, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []pipeline.Fragment); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Fragment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_Fragments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fragments'
+type ORM_Fragments_Call struct {
+	*mock.Call
+}
+
+// Fragments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) Fragments(ctx interface{}, offset interface{}, limit interface{}) *ORM_Fragments_Call {
+	return &ORM_Fragments_Call{Call: _e.mock.On("Fragments", ctx, offset, limit)}
+}
+
+func (_c *ORM_Fragments_Call) Run(run func(ctx context.Context, offset int, limit int)) *ORM_Fragments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_Fragments_Call) Return(_a0 []pipeline.Fragment, _a1 int, _a2 error) *ORM_Fragments_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_Fragments_Call) RunAndReturn(run func(context.Context, int, int) ([]pipeline.Fragment, int, error)) *ORM_Fragments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllRuns provides a mock function with given fields: ctx
 func (_m *ORM) GetAllRuns(ctx context.Context) ([]pipeline.Run, error) {
 	ret := _m.Called(ctx)
@@ -1025,6 +1362,54 @@ func (_c *ORM_UpdateTaskRunResult_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// UpsertHTTPResponse provides a mock function with given fields: ctx, key, response
+func (_m *ORM) UpsertHTTPResponse(ctx context.Context, key string, response []byte) error {
+	ret := _m.Called(ctx, key, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertHTTPResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = rf(ctx, key, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpsertHTTPResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertHTTPResponse'
+type ORM_UpsertHTTPResponse_Call struct {
+	*mock.Call
+}
+
+// UpsertHTTPResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - response []byte
+func (_e *ORM_Expecter) UpsertHTTPResponse(ctx interface{}, key interface{}, response interface{}) *ORM_UpsertHTTPResponse_Call {
+	return &ORM_UpsertHTTPResponse_Call{Call: _e.mock.On("UpsertHTTPResponse", ctx, key, response)}
+}
+
+func (_c *ORM_UpsertHTTPResponse_Call) Run(run func(ctx context.Context, key string, response []byte)) *ORM_UpsertHTTPResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *ORM_UpsertHTTPResponse_Call) Return(_a0 error) *ORM_UpsertHTTPResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpsertHTTPResponse_Call) RunAndReturn(run func(context.Context, string, []byte) error) *ORM_UpsertHTTPResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: _a0
 func (_m *ORM) WithDataSource(_a0 sqlutil.DataSource) pipeline.ORM {
 	ret := _m.Called(_a0)
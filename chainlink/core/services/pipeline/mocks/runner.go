@@ -534,6 +534,76 @@ func (_c *Runner_Ready_Call) RunAndReturn(run func() error) *Runner_Ready_Call {
 	return _c
 }
 
+// ReplayRun provides a mock function with given fields: ctx, spec, bundle, recordedTasks
+func (_m *Runner) ReplayRun(ctx context.Context, spec pipeline.Spec, bundle pipeline.RunBundle, recordedTasks []string) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, bundle, recordedTasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.RunBundle, []string) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, bundle, recordedTasks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.RunBundle, []string) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, bundle, recordedTasks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.RunBundle, []string) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, bundle, recordedTasks)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.RunBundle, []string) error); ok {
+		r2 = rf(ctx, spec, bundle, recordedTasks)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_ReplayRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRun'
+type Runner_ReplayRun_Call struct {
+	*mock.Call
+}
+
+// ReplayRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - bundle pipeline.RunBundle
+//   - recordedTasks []string
+func (_e *Runner_Expecter) ReplayRun(ctx interface{}, spec interface{}, bundle interface{}, recordedTasks interface{}) *Runner_ReplayRun_Call {
+	return &Runner_ReplayRun_Call{Call: _e.mock.On("ReplayRun", ctx, spec, bundle, recordedTasks)}
+}
+
+func (_c *Runner_ReplayRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, bundle pipeline.RunBundle, recordedTasks []string)) *Runner_ReplayRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.RunBundle), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) Return(run *pipeline.Run, trrs pipeline.TaskRunResults, err error) *Runner_ReplayRun_Call {
+	_c.Call.Return(run, trrs, err)
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.RunBundle, []string) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_ReplayRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
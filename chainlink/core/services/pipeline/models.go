@@ -63,6 +63,8 @@ type Run struct {
 	FinishedAt       null.Time                         `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun                         `json:"taskRuns"`
 	State            RunStatus                         `json:"state"`
+	// RetryOfRunID is the ID of the run this run retries, if any. It may have been pruned since.
+	RetryOfRunID null.Int `json:"retryOfRunID"`
 
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
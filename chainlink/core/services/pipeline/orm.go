@@ -94,6 +94,15 @@ type ORM interface {
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
+	CreateFragment(ctx context.Context, fragment *Fragment) error
+	FindFragment(ctx context.Context, name string) (Fragment, error)
+	Fragments(ctx context.Context, offset, limit int) ([]Fragment, int, error)
+	DeleteFragment(ctx context.Context, name string) error
+	FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error)
+
+	FindHTTPResponse(ctx context.Context, key string, maxAge time.Duration) ([]byte, error)
+	UpsertHTTPResponse(ctx context.Context, key string, response []byte) error
+
 	DataSource() sqlutil.DataSource
 	WithDataSource(sqlutil.DataSource) ORM
 	Transact(context.Context, func(ORM) error) error
@@ -184,11 +193,22 @@ func (o *orm) transact(ctx context.Context, fn func(*orm) error) error {
 }
 
 func (o *orm) CreateSpec(ctx context.Context, pipeline Pipeline, maxTaskDuration sqlutil.Interval) (id int32, err error) {
-	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
-	VALUES ($1, $2, NOW())
-	RETURNING id;`
-	err = o.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration)
-	return id, errors.WithStack(err)
+	err = o.transact(ctx, func(tx *orm) error {
+		sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
+		VALUES ($1, $2, NOW())
+		RETURNING id;`
+		if err = tx.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration); err != nil {
+			return errors.WithStack(err)
+		}
+		for _, name := range pipeline.Fragments() {
+			sql = `INSERT INTO pipeline_spec_fragments (pipeline_spec_id, fragment_name) VALUES ($1, $2);`
+			if _, err = tx.ds.ExecContext(ctx, sql, id, name); err != nil {
+				return errors.Wrapf(err, "failed to record fragment %s", name)
+			}
+		}
+		return nil
+	})
+	return id, err
 }
 
 func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
@@ -225,8 +245,8 @@ func (o *orm) InsertRun(ctx context.Context, run *Run) error {
 	if run.Status() == RunStatusCompleted {
 		defer o.prune(ctx, o.ds, run.PruningKey)
 	}
-	query, args, err := o.ds.BindNamed(`INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+	query, args, err := o.ds.BindNamed(`INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, retry_of_run_id)
+		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :retry_of_run_id)
 		RETURNING *;`, run)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -364,9 +384,9 @@ func (o *orm) InsertFinishedRuns(ctx context.Context, runs []*Run, saveSuccessfu
 	err := o.transact(ctx, func(tx *orm) error {
 		pipelineRunsQuery := `
 INSERT INTO pipeline_runs 
-	(pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
+	(pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, retry_of_run_id)
 VALUES 
-	(:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state) 
+	(:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :retry_of_run_id) 
 RETURNING id
 	`
 
@@ -480,8 +500,8 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 }
 
 func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) error {
-	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, retry_of_run_id)
+		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :retry_of_run_id)
 		RETURNING id;`
 
 	query, args, err := o.ds.BindNamed(sql, run)
@@ -549,6 +569,12 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 		return errors.Wrap(err, "DeleteRunsOlderThan failed")
 	}
 
+	// The responses stored for the http task cache fallback are only ever served for their cacheTTL, which is
+	// shorter than the retention of the runs they were fetched for.
+	if _, err = o.ds.ExecContext(ctx, `DELETE FROM http_response_cache WHERE fetched_at < $1`, queryThreshold); err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed to delete old http_response_cache rows")
+	}
+
 	deleteTS := time.Now()
 
 	o.lggr.Debugw("pipeline_runs reaper DELETE query completed", "rowsDeleted", rowsDeleted, "duration", deleteTS.Sub(start))
@@ -767,3 +793,76 @@ LIMIT $3
 		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	}
 }
+
+// CreateFragment saves the fragment.
+func (o *orm) CreateFragment(ctx context.Context, fragment *Fragment) error {
+	if err := ValidateFragment(*fragment); err != nil {
+		return err
+	}
+	stmt := `INSERT INTO pipeline_fragments (name, source, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *;`
+	if err := o.ds.GetContext(ctx, fragment, stmt, fragment.Name, fragment.Source); err != nil {
+		return errors.Wrap(err, "CreateFragment failed")
+	}
+	return nil
+}
+
+// FindFragment looks up a fragment by name.
+func (o *orm) FindFragment(ctx context.Context, name string) (fragment Fragment, err error) {
+	err = o.ds.GetContext(ctx, &fragment, `SELECT * FROM pipeline_fragments WHERE name = $1`, name)
+	return
+}
+
+// Fragments returns a page of fragments, ordered by name, and the total count.
+func (o *orm) Fragments(ctx context.Context, offset, limit int) (frags []Fragment, count int, err error) {
+	err = o.transact(ctx, func(tx *orm) error {
+		if err = tx.ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM pipeline_fragments`); err != nil {
+			return errors.Wrap(err, "Fragments failed to get count")
+		}
+		if err = tx.ds.SelectContext(ctx, &frags, `SELECT * FROM pipeline_fragments ORDER BY name ASC LIMIT $1 OFFSET $2`, limit, offset); err != nil {
+			return errors.Wrap(err, "Fragments failed to load pipeline_fragments")
+		}
+		return nil
+	})
+	return
+}
+
+// DeleteFragment removes the fragment. Pipelines which include it were expanded when they were
+// saved, so they are not affected, but their specs can no longer be replaced or rolled back to.
+func (o *orm) DeleteFragment(ctx context.Context, name string) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_fragments WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrap(err, "DeleteFragment failed")
+	}
+	if rowsAffected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// FindJobIDsWithFragment returns the IDs of the jobs whose pipelines include the fragment.
+func (o *orm) FindJobIDsWithFragment(ctx context.Context, name string) (jids []int32, err error) {
+	query := `SELECT DISTINCT job_pipeline_specs.job_id
+		FROM job_pipeline_specs
+			JOIN pipeline_spec_fragments ON pipeline_spec_fragments.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id
+		WHERE pipeline_spec_fragments.fragment_name = $1 ORDER BY job_pipeline_specs.job_id`
+	if err = o.ds.SelectContext(ctx, &jids, query, name); err != nil {
+		return nil, errors.Wrap(err, "FindJobIDsWithFragment failed")
+	}
+	return jids, nil
+}
+
+// FindHTTPResponse returns the response stored for the http task cache key, if it was fetched within maxAge.
+// Returns sql.ErrNoRows if there is none.
+func (o *orm) FindHTTPResponse(ctx context.Context, key string, maxAge time.Duration) (response []byte, err error) {
+	err = o.ds.GetContext(ctx, &response, `SELECT value FROM http_response_cache WHERE key = $1 AND fetched_at > $2`, key, time.Now().Add(-maxAge))
+	return response, errors.Wrap(err, "FindHTTPResponse failed")
+}
+
+// UpsertHTTPResponse stores the last response fetched for the http task cache key.
+func (o *orm) UpsertHTTPResponse(ctx context.Context, key string, response []byte) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO http_response_cache (key, value, fetched_at) VALUES ($1, $2, now())
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, fetched_at = EXCLUDED.fetched_at`, key, response)
+	return errors.Wrap(err, "UpsertHTTPResponse failed")
+}
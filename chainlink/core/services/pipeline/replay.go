@@ -0,0 +1,294 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// RunBundleVersion is the version of the RunBundle format written by NewRunBundle.
+const RunBundleVersion = 1
+
+var (
+	// ErrUnsupportedRunBundle is returned when replaying a bundle written by an incompatible version.
+	ErrUnsupportedRunBundle = errors.New("unsupported run bundle version")
+	// ErrUnknownReplayTask is returned when a task selected to use its recorded output is not in the spec.
+	ErrUnknownReplayTask = errors.New("task is not in the current spec")
+	// ErrNoRecordedTaskRun is returned when a task selected to use its recorded output has no recorded run.
+	ErrNoRecordedTaskRun = errors.New("task has no recorded run")
+	// ErrUnrecordedSideEffect is the result of ethtx tasks without a recorded run, which are never executed
+	// by a replay.
+	ErrUnrecordedSideEffect = errors.New("ethtx tasks are not executed by replays and have no recorded run")
+)
+
+// RunBundle is a portable export of a pipeline run: the spec it was executed with, its vars, and the inputs
+// and outputs of every recorded task run. Runs are only recorded with their task runs when they failed, or
+// when the job saves successful task runs.
+type RunBundle struct {
+	Version       int                               `json:"version"`
+	RunID         int64                             `json:"runID"`
+	JobID         int32                             `json:"jobID"`
+	ExternalJobID uuid.UUID                         `json:"externalJobID"`
+	JobName       string                            `json:"jobName"`
+	DotDagSource  string                            `json:"dotDagSource"`
+	State         RunStatus                         `json:"state"`
+	Vars          jsonserializable.JSONSerializable `json:"vars"`
+	Meta          jsonserializable.JSONSerializable `json:"meta"`
+	Outputs       jsonserializable.JSONSerializable `json:"outputs"`
+	AllErrors     RunErrors                         `json:"allErrors"`
+	FatalErrors   RunErrors                         `json:"fatalErrors"`
+	CreatedAt     time.Time                         `json:"createdAt"`
+	FinishedAt    null.Time                         `json:"finishedAt"`
+	TaskRuns      []RunBundleTaskRun                `json:"taskRuns"`
+}
+
+// RunBundleTaskRun is the recorded run of a single task. Inputs holds the outputs of the tasks it depends
+// on, keyed by their DOT ID.
+type RunBundleTaskRun struct {
+	DotID      string                                       `json:"dotID"`
+	Type       TaskType                                     `json:"type"`
+	Inputs     map[string]jsonserializable.JSONSerializable `json:"inputs"`
+	Output     jsonserializable.JSONSerializable            `json:"output"`
+	Error      null.String                                  `json:"error"`
+	CreatedAt  time.Time                                    `json:"createdAt"`
+	FinishedAt null.Time                                    `json:"finishedAt"`
+}
+
+func (tr RunBundleTaskRun) result() Result {
+	if tr.Error.Valid {
+		return Result{Error: errors.New(tr.Error.String)}
+	}
+	return Result{Value: tr.Output.Val}
+}
+
+// NewRunBundle exports run, which must have been loaded with its spec and task runs.
+func NewRunBundle(run Run, externalJobID uuid.UUID) (RunBundle, error) {
+	bundle := RunBundle{
+		Version:       RunBundleVersion,
+		RunID:         run.ID,
+		JobID:         run.PipelineSpec.JobID,
+		ExternalJobID: externalJobID,
+		JobName:       run.PipelineSpec.JobName,
+		DotDagSource:  run.PipelineSpec.DotDagSource,
+		State:         run.State,
+		Vars:          run.Inputs,
+		Meta:          run.Meta,
+		Outputs:       run.Outputs,
+		AllErrors:     run.AllErrors,
+		FatalErrors:   run.FatalErrors,
+		CreatedAt:     run.CreatedAt,
+		FinishedAt:    run.FinishedAt,
+	}
+
+	p, err := Parse(run.PipelineSpec.DotDagSource)
+	if err != nil {
+		return bundle, errors.Wrap(err, "failed to parse the pipeline spec of the run")
+	}
+	outputs := make(map[string]jsonserializable.JSONSerializable, len(run.PipelineTaskRuns))
+	for _, tr := range run.PipelineTaskRuns {
+		outputs[tr.DotID] = tr.Output
+	}
+	for _, tr := range run.PipelineTaskRuns {
+		btr := RunBundleTaskRun{
+			DotID:      tr.DotID,
+			Type:       tr.Type,
+			Inputs:     map[string]jsonserializable.JSONSerializable{},
+			Output:     tr.Output,
+			Error:      tr.Error,
+			CreatedAt:  tr.CreatedAt,
+			FinishedAt: tr.FinishedAt,
+		}
+		if task := p.ByDotID(tr.DotID); task != nil {
+			for _, input := range task.Inputs() {
+				btr.Inputs[input.InputTask.DotID()] = outputs[input.InputTask.DotID()]
+			}
+		}
+		bundle.TaskRuns = append(bundle.TaskRuns, btr)
+	}
+	return bundle, nil
+}
+
+func (b RunBundle) taskRun(dotID string) (RunBundleTaskRun, bool) {
+	for _, tr := range b.TaskRuns {
+		if tr.DotID == dotID {
+			return tr, true
+		}
+	}
+	return RunBundleTaskRun{}, false
+}
+
+// vars returns a copy of the vars of the bundle, so that replays do not modify it.
+func (b RunBundle) vars() (Vars, error) {
+	m := map[string]any{}
+	if !b.Vars.Valid {
+		return NewVarsFrom(m), nil
+	}
+	bs, err := json.Marshal(b.Vars)
+	if err != nil {
+		return Vars{}, err
+	}
+	var copied jsonserializable.JSONSerializable
+	if err = json.Unmarshal(bs, &copied); err != nil {
+		return Vars{}, err
+	}
+	m, ok := copied.Val.(map[string]any)
+	if !ok {
+		return Vars{}, errors.Errorf("run bundle vars must be an object, got %T", copied.Val)
+	}
+	return NewVarsFrom(m), nil
+}
+
+// replayedTask returns the recorded result of the wrapped task instead of executing it.
+type replayedTask struct {
+	Task
+	result Result
+}
+
+func (t *replayedTask) Run(context.Context, logger.Logger, Vars, []Result) (Result, RunInfo) {
+	return t.result, RunInfo{}
+}
+
+// ReplayRun executes spec in-memory with the vars of bundle. The tasks listed in recordedTasks return their
+// output from the bundle instead of being executed, and so do ethtx tasks, which are never executed.
+func (r *runner) ReplayRun(ctx context.Context, spec Spec, bundle RunBundle, recordedTasks []string) (*Run, TaskRunResults, error) {
+	if bundle.Version != RunBundleVersion {
+		return nil, nil, errors.Wrapf(ErrUnsupportedRunBundle, "got version %d, expected %d", bundle.Version, RunBundleVersion)
+	}
+	vars, err := bundle.vars()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// always parse a fresh copy, since the tasks are wrapped below
+	spec.Pipeline = nil
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, dotID := range recordedTasks {
+		task := p.ByDotID(dotID)
+		if task == nil {
+			return nil, nil, errors.Wrapf(ErrUnknownReplayTask, "cannot use recorded output of %s", dotID)
+		}
+		tr, ok := bundle.taskRun(dotID)
+		if !ok {
+			return nil, nil, errors.Wrapf(ErrNoRecordedTaskRun, "cannot use recorded output of %s", dotID)
+		}
+		p.Tasks[task.ID()] = &replayedTask{Task: task, result: tr.result()}
+	}
+	for i, task := range p.Tasks {
+		if _, ok := task.(*replayedTask); ok || task.Type() != TaskTypeETHTx {
+			continue
+		}
+		result := Result{Error: errors.Wrap(ErrUnrecordedSideEffect, task.DotID())}
+		if tr, ok := bundle.taskRun(task.DotID()); ok {
+			result = tr.result()
+		}
+		p.Tasks[i] = &replayedTask{Task: task, result: result}
+	}
+
+	run := NewRun(spec, vars)
+	trrs := r.run(ctx, p, run, vars)
+	if run.Pending {
+		return run, nil, errors.Errorf("unexpected async run for spec ID %v, async tasks cannot be replayed", spec.ID)
+	}
+	return run, trrs, nil
+}
+
+// TaskReplayStatus describes how the result of a task in a replayed run compares with its recorded run.
+type TaskReplayStatus string
+
+const (
+	TaskReplayUnchanged TaskReplayStatus = "unchanged"
+	TaskReplayChanged   TaskReplayStatus = "changed"
+	// TaskReplayAdded is used for tasks which have no recorded run, either because they were added to the
+	// spec or because only the task runs of failed runs are recorded.
+	TaskReplayAdded TaskReplayStatus = "added"
+	// TaskReplayRemoved is used for recorded tasks which are not in the current spec.
+	TaskReplayRemoved TaskReplayStatus = "removed"
+)
+
+// TaskReplayDiff compares the result of a task in a replayed run with its recorded run.
+type TaskReplayDiff struct {
+	DotID          string                            `json:"dotID"`
+	Type           TaskType                          `json:"type"`
+	Status         TaskReplayStatus                  `json:"status"`
+	UsedRecorded   bool                              `json:"usedRecorded"`
+	RecordedOutput jsonserializable.JSONSerializable `json:"recordedOutput"`
+	RecordedError  null.String                       `json:"recordedError"`
+	ReplayedOutput jsonserializable.JSONSerializable `json:"replayedOutput"`
+	ReplayedError  null.String                       `json:"replayedError"`
+}
+
+// DiffReplayedRun compares the task results of a replay of bundle with its recorded task runs. Outputs are
+// compared by their JSON encoding, since recorded outputs have been through a JSON round trip.
+func DiffReplayedRun(bundle RunBundle, trrs TaskRunResults) ([]TaskReplayDiff, error) {
+	sorted := make(TaskRunResults, len(trrs))
+	copy(sorted, trrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Task.ID() < sorted[j].Task.ID()
+	})
+
+	var diffs []TaskReplayDiff
+	replayed := make(map[string]bool, len(sorted))
+	for _, trr := range sorted {
+		_, usedRecorded := trr.Task.(*replayedTask)
+		diff := TaskReplayDiff{
+			DotID:          trr.Task.DotID(),
+			Type:           trr.Task.Type(),
+			Status:         TaskReplayAdded,
+			UsedRecorded:   usedRecorded,
+			ReplayedOutput: trr.Result.OutputDB(),
+			ReplayedError:  trr.Result.ErrorDB(),
+		}
+		replayed[diff.DotID] = true
+		if tr, ok := bundle.taskRun(diff.DotID); ok {
+			diff.RecordedOutput = tr.Output
+			diff.RecordedError = tr.Error
+			equal, err := outputsEqual(tr.Output, diff.ReplayedOutput)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to compare outputs of task %s", diff.DotID)
+			}
+			if equal && tr.Error == diff.ReplayedError {
+				diff.Status = TaskReplayUnchanged
+			} else {
+				diff.Status = TaskReplayChanged
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+	for _, tr := range bundle.TaskRuns {
+		if replayed[tr.DotID] {
+			continue
+		}
+		diffs = append(diffs, TaskReplayDiff{
+			DotID:          tr.DotID,
+			Type:           tr.Type,
+			Status:         TaskReplayRemoved,
+			RecordedOutput: tr.Output,
+			RecordedError:  tr.Error,
+		})
+	}
+	return diffs, nil
+}
+
+func outputsEqual(a, b jsonserializable.JSONSerializable) (bool, error) {
+	ab, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ab, bb), nil
+}
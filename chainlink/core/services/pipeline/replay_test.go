@@ -0,0 +1,160 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const replayDAG = `
+ds       [type=memo value=10];
+multiply [type=multiply input="$(ds)" times=2];
+submit   [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(multiply)"];
+
+ds -> multiply -> submit;
+`
+
+const replayBundle = `{
+	"version": 1,
+	"runID": 7,
+	"jobID": 1,
+	"vars": {"jobRun": {"meta": {}}},
+	"taskRuns": [
+		{"dotID": "ds", "type": "memo", "inputs": {}, "output": "5", "error": null},
+		{"dotID": "multiply", "type": "multiply", "inputs": {"ds": "5"}, "output": "10", "error": null},
+		{"dotID": "submit", "type": "ethtx", "inputs": {"multiply": "10"}, "output": {"txHash": "0x01"}, "error": null},
+		{"dotID": "removed", "type": "memo", "inputs": {}, "output": "1", "error": null}
+	]
+}`
+
+func newReplayRunner(t *testing.T) pipeline.Runner {
+	cfg := configtest.NewTestGeneralConfig(t)
+	return pipeline.NewRunner(nil, nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func decodeReplayBundle(t *testing.T) pipeline.RunBundle {
+	var bundle pipeline.RunBundle
+	require.NoError(t, json.Unmarshal([]byte(replayBundle), &bundle))
+	return bundle
+}
+
+func replayDiffsByDotID(t *testing.T, bundle pipeline.RunBundle, trrs pipeline.TaskRunResults) map[string]pipeline.TaskReplayDiff {
+	diffs, err := pipeline.DiffReplayedRun(bundle, trrs)
+	require.NoError(t, err)
+	byDotID := make(map[string]pipeline.TaskReplayDiff, len(diffs))
+	for _, diff := range diffs {
+		byDotID[diff.DotID] = diff
+	}
+	return byDotID
+}
+
+func TestRunner_ReplayRun(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{DotDagSource: replayDAG}
+
+	t.Run("recorded tasks", func(t *testing.T) {
+		bundle := decodeReplayBundle(t)
+		run, trrs, err := newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, []string{"ds"})
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+
+		diffs := replayDiffsByDotID(t, bundle, trrs)
+		require.Len(t, diffs, 4)
+		assert.Equal(t, pipeline.TaskReplayUnchanged, diffs["ds"].Status)
+		assert.True(t, diffs["ds"].UsedRecorded)
+		assert.Equal(t, pipeline.TaskReplayUnchanged, diffs["multiply"].Status)
+		assert.False(t, diffs["multiply"].UsedRecorded)
+		// ethtx tasks always use their recorded output
+		assert.Equal(t, pipeline.TaskReplayUnchanged, diffs["submit"].Status)
+		assert.True(t, diffs["submit"].UsedRecorded)
+		assert.Equal(t, pipeline.TaskReplayRemoved, diffs["removed"].Status)
+	})
+
+	t.Run("changed outputs", func(t *testing.T) {
+		bundle := decodeReplayBundle(t)
+		_, trrs, err := newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, nil)
+		require.NoError(t, err)
+
+		diffs := replayDiffsByDotID(t, bundle, trrs)
+		assert.Equal(t, pipeline.TaskReplayChanged, diffs["ds"].Status)
+		assert.Equal(t, pipeline.TaskReplayChanged, diffs["multiply"].Status)
+		b, err := json.Marshal(diffs["multiply"].ReplayedOutput)
+		require.NoError(t, err)
+		assert.JSONEq(t, `"20"`, string(b))
+	})
+
+	t.Run("unrecorded ethtx task", func(t *testing.T) {
+		bundle := decodeReplayBundle(t)
+		bundle.TaskRuns = bundle.TaskRuns[:2]
+		run, trrs, err := newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, nil)
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+
+		diffs := replayDiffsByDotID(t, bundle, trrs)
+		assert.Equal(t, pipeline.TaskReplayAdded, diffs["submit"].Status)
+		assert.Contains(t, diffs["submit"].ReplayedError.String, pipeline.ErrUnrecordedSideEffect.Error())
+	})
+
+	t.Run("recorded error", func(t *testing.T) {
+		bundle := decodeReplayBundle(t)
+		bundle.TaskRuns[0].Output = jsonserializable.JSONSerializable{}
+		bundle.TaskRuns[0].Error = null.StringFrom("connection refused")
+		run, _, err := newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, []string{"ds"})
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+	})
+
+	t.Run("invalid replays", func(t *testing.T) {
+		bundle := decodeReplayBundle(t)
+		_, _, err := newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, []string{"unknown"})
+		require.ErrorIs(t, err, pipeline.ErrUnknownReplayTask)
+
+		bundle.TaskRuns = bundle.TaskRuns[1:]
+		_, _, err = newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, []string{"ds"})
+		require.ErrorIs(t, err, pipeline.ErrNoRecordedTaskRun)
+
+		bundle.Version = pipeline.RunBundleVersion + 1
+		_, _, err = newReplayRunner(t).ReplayRun(testutils.Context(t), spec, bundle, nil)
+		require.ErrorIs(t, err, pipeline.ErrUnsupportedRunBundle)
+	})
+}
+
+func TestNewRunBundle(t *testing.T) {
+	t.Parallel()
+
+	output := func(v any) jsonserializable.JSONSerializable {
+		return jsonserializable.JSONSerializable{Val: v, Valid: true}
+	}
+	run := pipeline.Run{
+		ID:           7,
+		State:        pipeline.RunStatusErrored,
+		PipelineSpec: pipeline.Spec{JobID: 1, JobName: "replayed", DotDagSource: replayDAG},
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds", Type: pipeline.TaskTypeMemo, Output: output("5")},
+			{DotID: "multiply", Type: pipeline.TaskTypeMultiply, Output: output("10")},
+			{DotID: "submit", Type: pipeline.TaskTypeETHTx, Error: null.StringFrom("insufficient funds")},
+		},
+	}
+
+	bundle, err := pipeline.NewRunBundle(run, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunBundleVersion, bundle.Version)
+	assert.Equal(t, int64(7), bundle.RunID)
+	assert.Equal(t, "replayed", bundle.JobName)
+	require.Len(t, bundle.TaskRuns, 3)
+	assert.Empty(t, bundle.TaskRuns[0].Inputs)
+	assert.Equal(t, "5", bundle.TaskRuns[1].Inputs["ds"].Val)
+	assert.Equal(t, "10", bundle.TaskRuns[2].Inputs["multiply"].Val)
+	assert.Equal(t, "insufficient funds", bundle.TaskRuns[2].Error.String)
+}
@@ -0,0 +1,158 @@
+package pipeline
+
+import (
+	"maps"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	// ErrRunNotRetryable is returned when retrying a run which is not finished, or finished without errors.
+	ErrRunNotRetryable = errors.New("only finished runs with errors can be retried")
+	// ErrRetrySpecChanged is returned when reusing the task results of a run whose job spec has changed since.
+	ErrRetrySpecChanged = errors.New("the job spec has changed since the run, its task results cannot be reused")
+	// ErrRetrySentTransaction is returned when retrying a run whose succeeded ethtx tasks cannot be reused, since
+	// executing them again would send their transactions again.
+	ErrRetrySentTransaction = errors.New("ethtx tasks which succeeded are never executed again by a retry")
+)
+
+// RetryOptions configures the retry of a run, see NewRetryRun.
+type RetryOptions struct {
+	// Vars are merged into the vars of the original run. Nested objects are merged key by key, other values
+	// replace the original ones.
+	Vars map[string]any
+	// SkipSucceeded reuses the results of the tasks which succeeded in the original run, instead of executing
+	// them again. A task is only reused when all the tasks it depends on are reused too. The ethtx tasks which
+	// succeeded are always reused, see NewRetryRun.
+	SkipSucceeded bool
+}
+
+// NewRetryRun returns a new run of spec, linked to original, which must be a finished run with errors loaded
+// with its task runs. The run starts from the vars of original, without the results of its tasks, and is
+// meant to be executed with Runner.Run, which resumes from the task runs reused with opts.SkipSucceeded.
+//
+// The ethtx tasks which succeeded in original are reused even without opts.SkipSucceeded, along with the
+// results of all the tasks they depend on, so that their transactions are not sent again. The retry fails
+// with ErrRetrySentTransaction if they cannot be reused.
+func NewRetryRun(spec Spec, original Run, opts RetryOptions) (*Run, error) {
+	if !original.FinishedAt.Valid || !original.HasErrors() {
+		return nil, errors.Wrapf(ErrRunNotRetryable, "run %d is %s", original.ID, original.State)
+	}
+	var sentTransactions []TaskRun
+	for _, tr := range original.PipelineTaskRuns {
+		if tr.Type == TaskTypeETHTx && tr.FinishedAt.Valid && !tr.Error.Valid {
+			sentTransactions = append(sentTransactions, tr)
+		}
+	}
+	if spec.ID != original.PipelineSpecID {
+		if len(sentTransactions) > 0 {
+			return nil, errors.Wrapf(ErrRetrySentTransaction, "run %d: %s", original.ID, ErrRetrySpecChanged)
+		}
+		if opts.SkipSucceeded {
+			return nil, errors.Wrapf(ErrRetrySpecChanged, "run %d", original.ID)
+		}
+	}
+	p, err := Parse(spec.DotDagSource)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]any{}
+	if m, ok := original.Inputs.Val.(map[string]any); ok {
+		maps.Copy(vars, m)
+	}
+	// the results of the original tasks are stored in its vars
+	for _, task := range p.Tasks {
+		delete(vars, task.DotID())
+	}
+	mergeVars(vars, opts.Vars)
+
+	run := NewRun(spec, NewVarsFrom(vars))
+	run.RetryOfRunID = null.IntFrom(original.ID)
+	if !opts.SkipSucceeded && len(sentTransactions) == 0 {
+		return run, nil
+	}
+
+	reusable := make(map[int]bool, len(p.Tasks))
+	if opts.SkipSucceeded {
+		var isReusable func(task Task) bool
+		isReusable = func(task Task) bool {
+			if ok, seen := reusable[task.ID()]; seen {
+				return ok
+			}
+			tr := original.ByDotID(task.DotID())
+			ok := tr != nil && tr.FinishedAt.Valid && !tr.Error.Valid
+			for _, input := range task.Inputs() {
+				ok = ok && isReusable(input.InputTask)
+			}
+			reusable[task.ID()] = ok
+			return ok
+		}
+		for _, task := range p.Tasks {
+			isReusable(task)
+		}
+	}
+	// a reused task must be reused with all the tasks it depends on, whatever their result, since the
+	// runner only resumes from tasks whose inputs are finished
+	var reuseWithInputs func(task Task) error
+	reuseWithInputs = func(task Task) error {
+		if reusable[task.ID()] {
+			return nil
+		}
+		if tr := original.ByDotID(task.DotID()); tr == nil || !tr.FinishedAt.Valid {
+			return errors.Wrapf(ErrRetrySentTransaction, "run %d: task %s has no finished run", original.ID, task.DotID())
+		}
+		reusable[task.ID()] = true
+		for _, input := range task.Inputs() {
+			if err := reuseWithInputs(input.InputTask); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, tr := range sentTransactions {
+		task := p.ByDotID(tr.DotID)
+		if task == nil {
+			return nil, errors.Wrapf(ErrRetrySentTransaction, "run %d: task %s is not in the spec", original.ID, tr.DotID)
+		}
+		if err := reuseWithInputs(task); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, task := range p.Tasks {
+		if !reusable[task.ID()] {
+			continue
+		}
+		tr := original.ByDotID(task.DotID())
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
+			ID:         uuid.New(),
+			Type:       tr.Type,
+			Index:      tr.Index,
+			Output:     tr.Output,
+			Error:      tr.Error,
+			DotID:      tr.DotID,
+			CreatedAt:  tr.CreatedAt,
+			FinishedAt: tr.FinishedAt,
+		})
+	}
+	return run, nil
+}
+
+// mergeVars merges src into dst, recursing into the objects present in both.
+func mergeVars(dst, src map[string]any) {
+	for k, v := range src {
+		srcMap, srcOK := v.(map[string]any)
+		dstMap, dstOK := dst[k].(map[string]any)
+		if srcOK && dstOK {
+			// copy, so that the original vars are not modified
+			merged := maps.Clone(dstMap)
+			mergeVars(merged, srcMap)
+			dst[k] = merged
+			continue
+		}
+		dst[k] = v
+	}
+}
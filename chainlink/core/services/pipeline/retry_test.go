@@ -0,0 +1,159 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestNewRetryRun(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{
+		ID: 2,
+		DotDagSource: `
+ds1       [type=bridge name=adapter];
+ds1_parse [type=jsonparse path="result"];
+ds2       [type=memo value=2];
+answer    [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> answer;
+`,
+	}
+	finished := null.TimeFrom(time.Now())
+	output := func(v any) jsonserializable.JSONSerializable {
+		return jsonserializable.JSONSerializable{Val: v, Valid: true}
+	}
+	original := pipeline.Run{
+		ID:             7,
+		PipelineSpecID: 2,
+		State:          pipeline.RunStatusErrored,
+		FinishedAt:     finished,
+		AllErrors:      pipeline.RunErrors{null.StringFrom("adapter unavailable")},
+		Inputs: output(map[string]any{
+			"jobRun": map[string]any{"meta": map[string]any{"id": "1"}, "requestBody": "{}"},
+			"ds1":    map[string]any{},
+			"ds2":    "2",
+		}),
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds1", Type: pipeline.TaskTypeBridge, Error: null.StringFrom("adapter unavailable"), FinishedAt: finished},
+			{DotID: "ds1_parse", Type: pipeline.TaskTypeJSONParse, Error: null.StringFrom("upstream error"), FinishedAt: finished},
+			{DotID: "ds2", Type: pipeline.TaskTypeMemo, Output: output("2"), FinishedAt: finished},
+			{DotID: "answer", Type: pipeline.TaskTypeMedian, Error: null.StringFrom("too many errors"), FinishedAt: finished},
+		},
+	}
+
+	t.Run("vars", func(t *testing.T) {
+		run, err := pipeline.NewRetryRun(spec, original, pipeline.RetryOptions{
+			Vars: map[string]any{"jobRun": map[string]any{"requestBody": `{"fixed":true}`}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), run.RetryOfRunID.Int64)
+		assert.Equal(t, int32(2), run.PipelineSpecID)
+		assert.Empty(t, run.PipelineTaskRuns)
+		assert.Equal(t, map[string]any{
+			"jobRun": map[string]any{"meta": map[string]any{"id": "1"}, "requestBody": `{"fixed":true}`},
+		}, run.Inputs.Val)
+		// the original vars are left untouched
+		assert.Equal(t, "{}", original.Inputs.Val.(map[string]any)["jobRun"].(map[string]any)["requestBody"])
+	})
+
+	t.Run("skip succeeded", func(t *testing.T) {
+		run, err := pipeline.NewRetryRun(spec, original, pipeline.RetryOptions{SkipSucceeded: true})
+		require.NoError(t, err)
+		require.Len(t, run.PipelineTaskRuns, 1)
+		reused := run.PipelineTaskRuns[0]
+		assert.Equal(t, "ds2", reused.DotID)
+		assert.Equal(t, "2", reused.Output.Val)
+		assert.NotEqual(t, original.PipelineTaskRuns[2].ID, reused.ID)
+	})
+
+	t.Run("task with failed dependency", func(t *testing.T) {
+		partial := original
+		partial.PipelineTaskRuns = []pipeline.TaskRun{
+			original.PipelineTaskRuns[0],
+			{DotID: "ds1_parse", Type: pipeline.TaskTypeJSONParse, Output: output("1"), FinishedAt: finished},
+			original.PipelineTaskRuns[2],
+		}
+		run, err := pipeline.NewRetryRun(spec, partial, pipeline.RetryOptions{SkipSucceeded: true})
+		require.NoError(t, err)
+		require.Len(t, run.PipelineTaskRuns, 1)
+		assert.Equal(t, "ds2", run.PipelineTaskRuns[0].DotID)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		completed := original
+		completed.State = pipeline.RunStatusCompleted
+		completed.AllErrors = pipeline.RunErrors{null.String{}}
+		_, err := pipeline.NewRetryRun(spec, completed, pipeline.RetryOptions{})
+		require.ErrorIs(t, err, pipeline.ErrRunNotRetryable)
+
+		running := original
+		running.State = pipeline.RunStatusRunning
+		running.FinishedAt = null.Time{}
+		_, err = pipeline.NewRetryRun(spec, running, pipeline.RetryOptions{})
+		require.ErrorIs(t, err, pipeline.ErrRunNotRetryable)
+
+		changed := spec
+		changed.ID = 3
+		_, err = pipeline.NewRetryRun(changed, original, pipeline.RetryOptions{SkipSucceeded: true})
+		require.ErrorIs(t, err, pipeline.ErrRetrySpecChanged)
+
+		// without reusing task results, the current spec is run from scratch
+		_, err = pipeline.NewRetryRun(changed, original, pipeline.RetryOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("succeeded ethtx", func(t *testing.T) {
+		txSpec := pipeline.Spec{
+			ID: 4,
+			DotDagSource: `
+ds     [type=bridge name=adapter];
+encode [type=ethabiencode abi="fulfill(uint256 v)" data=<{"v": $(ds)}>];
+submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode)"];
+notify [type=bridge name=notifier];
+
+ds -> encode -> submit -> notify;
+`,
+		}
+		sent := pipeline.Run{
+			ID:             8,
+			PipelineSpecID: 4,
+			State:          pipeline.RunStatusErrored,
+			FinishedAt:     finished,
+			AllErrors:      pipeline.RunErrors{null.StringFrom("notifier unavailable")},
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{DotID: "ds", Type: pipeline.TaskTypeBridge, Output: output("1"), FinishedAt: finished},
+				{DotID: "encode", Type: pipeline.TaskTypeETHABIEncode, Output: output("0x01"), FinishedAt: finished},
+				{DotID: "submit", Type: pipeline.TaskTypeETHTx, Output: output(map[string]any{}), FinishedAt: finished},
+				{DotID: "notify", Type: pipeline.TaskTypeBridge, Error: null.StringFrom("notifier unavailable"), FinishedAt: finished},
+			},
+		}
+
+		// the transaction is not sent again, even without reusing task results
+		run, err := pipeline.NewRetryRun(txSpec, sent, pipeline.RetryOptions{})
+		require.NoError(t, err)
+		var reused []string
+		for _, tr := range run.PipelineTaskRuns {
+			reused = append(reused, tr.DotID)
+		}
+		assert.Equal(t, []string{"ds", "encode", "submit"}, reused)
+
+		changed := txSpec
+		changed.ID = 5
+		_, err = pipeline.NewRetryRun(changed, sent, pipeline.RetryOptions{})
+		require.ErrorIs(t, err, pipeline.ErrRetrySentTransaction)
+
+		unfinished := sent
+		unfinished.PipelineTaskRuns = sent.PipelineTaskRuns[1:]
+		_, err = pipeline.NewRetryRun(txSpec, unfinished, pipeline.RetryOptions{})
+		require.ErrorIs(t, err, pipeline.ErrRetrySentTransaction)
+	})
+}
@@ -15,6 +15,8 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -41,6 +43,9 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// ReplayRun executes a new run in-memory according to a spec, with the vars of a previously exported run.
+	// The tasks listed in recordedTasks use their recorded output instead of being executed.
+	ReplayRun(ctx context.Context, spec Spec, bundle RunBundle, recordedTasks []string) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -69,6 +74,15 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *circuitBreakers
+	httpCache              *httpResponseCache
+	bridgeCoalescer        *bridgeCoalescer
+	bridgeHealth           *bridges.HealthChecker
+	tracer                 trace.Tracer
+
+	// wrapTask, if set, replaces each initialized task, including the tasks of
+	// sub-pipelines, see Simulator
+	wrapTask func(Task) Task
 
 	// test helper
 	runFinished func(*Run)
@@ -110,6 +124,7 @@ var (
 func NewRunner(
 	orm ORM,
 	btORM bridges.ORM,
+	bridgeHealth *bridges.HealthChecker,
 	cfg Config,
 	bridgeCfg BridgeConfig,
 	legacyChains legacyevm.LegacyChainContainer,
@@ -134,6 +149,11 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		circuitBreakers:        newCircuitBreakers(cfg),
+		httpCache:              newHTTPResponseCache(defaultHTTPCacheSize),
+		bridgeCoalescer:        newBridgeCoalescer(),
+		bridgeHealth:           bridgeHealth,
+		tracer:                 otel.Tracer(tracerName),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -183,6 +203,7 @@ func (r *runner) Name() string {
 
 func (r *runner) HealthReport() map[string]error {
 	runnerHealth := map[string]error{r.Name(): r.Healthy()}
+	r.circuitBreakers.healthReport(r.Name(), runnerHealth)
 
 	service, isService := r.btORM.(services.HealthReporter)
 	if !isService {
@@ -329,8 +350,15 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		return
 	}
 
-	// initialize certain task params
-	for _, task := range pipeline.Tasks {
+	r.initializeTasks(pipeline.Tasks, spec)
+
+	return pipeline, nil
+}
+
+// initializeTasks initializes certain task params, including those of tasks in
+// sub-pipelines.
+func (r *runner) initializeTasks(tasks []Task, spec Spec) {
+	for i, task := range tasks {
 		task.Base().uuid = uuid.New()
 
 		switch task.Type() {
@@ -338,6 +366,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).circuitBreakers = r.circuitBreakers
+			task.(*HTTPTask).httpCache = r.httpCache
+			task.(*HTTPTask).orm = r.orm
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -348,6 +379,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.circuitBreakers
+			task.(*BridgeTask).coalescer = r.bridgeCoalescer
+			task.(*BridgeTask).bridgeHealth = r.bridgeHealth
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -369,11 +403,63 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeForeach:
+			foreach := task.(*ForeachTask)
+			foreach.runBody = func(ctx context.Context, vars Vars) (Result, error) {
+				body, err := foreach.newBody()
+				if err != nil {
+					return Result{}, err
+				}
+				r.initializeTasks(body.Tasks, spec)
+				return r.runSubPipeline(ctx, spec, body, vars, r.lggr.With("foreachTask", foreach.DotID()))
+			}
 		default:
 		}
+
+		if r.wrapTask != nil {
+			tasks[i] = r.wrapTask(task)
+		}
 	}
+}
 
-	return pipeline, nil
+// runSubPipeline executes a pipeline nested within a task, such as the body of
+// a foreach task, and returns the result of its single terminal task. Task runs
+// of sub-pipelines are not persisted.
+func (r *runner) runSubPipeline(ctx context.Context, spec Spec, pipeline *Pipeline, vars Vars, l logger.Logger) (Result, error) {
+	scheduler := newScheduler(pipeline, &Run{}, vars, l)
+	go scheduler.Run()
+
+	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err any) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t,
+			})
+		})
+	}
+
+	if scheduler.pending {
+		return Result{}, pkgerrors.New("sub-pipeline cannot be suspended")
+	}
+	for _, result := range scheduler.results {
+		if len(result.Task.Outputs()) == 0 {
+			return result.Result, nil
+		}
+	}
+	return Result{}, pkgerrors.New("sub-pipeline has no terminal task")
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
@@ -382,6 +468,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
+	ctx, span := startRunSpan(ctx, r.tracer, run)
+	defer func() { endRunSpan(span, run) }()
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -562,7 +651,9 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx, span := startTaskSpan(ctx, r.tracer, taskRun)
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	endTaskSpan(span, result, runInfo)
 	loggerFields := []any{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -660,6 +751,10 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 			for _, task := range pipeline.Tasks {
 				switch task.Type() {
 				case TaskTypeETHTx:
+					if run.ByDotID(task.DotID()) != nil {
+						// reused from a retried run
+						continue
+					}
 					run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 						ID:            task.Base().uuid,
 						PipelineRunID: run.ID,
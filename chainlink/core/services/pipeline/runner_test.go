@@ -49,7 +49,7 @@ func newRunner(t testing.TB, db *sqlx.DB, bridgeORM bridges.ORM, cfg chainlink.G
 	})
 	orm := mocks.NewORM(t)
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, bridgeORM, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -523,7 +523,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		ID: 1,
@@ -569,7 +569,7 @@ func Test_PipelineRunner_ExecuteAndInsertFinishedRun_SavingTheSpec(t *testing.T)
 		KeyStore:       ethKeyStore,
 	})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		DotDagSource: `
@@ -1045,7 +1045,7 @@ func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 			KeyStore:       ethKeyStore,
 		})
 		lggr := logger.TestLogger(t)
-		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+		r := pipeline.NewRunner(nil, nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
 
 		template := `
 succeed             [type=memo value=%d]
@@ -1076,3 +1076,62 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_Foreach(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, db, btORM, cfg)
+
+	t.Run("collects results in order", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+			DotDagSource: `
+a [type=foreach values="$(words)" concurrency=2 body=<
+	lower [type=lowercase input="$(item)"];
+	merge [type=merge left="{}" right=<{"index": $(index), "word": $(lower)}>];
+>]
+`,
+		}, pipeline.NewVarsFrom(map[string]any{
+			"words": []any{"camelCase", "UPPERCASE", "lower"},
+		}))
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.False(t, trrs.FinalResult().HasFatalErrors())
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, []any{
+			map[string]any{"index": 0, "word": "camelcase"},
+			map[string]any{"index": 1, "word": "uppercase"},
+			map[string]any{"index": 2, "word": "lower"},
+		}, result.Value)
+	})
+
+	t.Run("fails on failed iterations", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+			DotDagSource: `
+a [type=foreach values="$(values)" body=<b [type=hexdecode input="$(item)"]>]
+`,
+		}, pipeline.NewVarsFrom(map[string]any{
+			"values": []any{"0x12", "not hex"},
+		}))
+		require.NoError(t, err)
+		assert.True(t, trrs.FinalResult().HasFatalErrors())
+	})
+
+	t.Run("tolerates allowed faults", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+			DotDagSource: `
+a [type=foreach values="$(values)" allowedFaults=1 body=<b [type=lowercase input="$(item)" failEarly=true]>]
+`,
+		}, pipeline.NewVarsFrom(map[string]any{
+			"values": []any{"A", 1},
+		}))
+		require.NoError(t, err)
+		assert.False(t, trrs.FinalResult().HasFatalErrors())
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, []any{"a", nil}, result.Value)
+	})
+}
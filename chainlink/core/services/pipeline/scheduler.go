@@ -135,6 +135,7 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		s.results[task.ID()] = TaskRunResult{
+			ID:         r.ID,
 			Task:       task,
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
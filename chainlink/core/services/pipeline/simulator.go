@@ -0,0 +1,232 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+
+	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// ErrNoSimulationFixture is returned by a simulated task which has no recorded
+// fixture and cannot be recorded.
+var ErrNoSimulationFixture = errors.New("no fixture recorded for task")
+
+// SimulationFixtures holds the recorded results of the network-bound tasks
+// (http, bridge and ethcall) of a pipeline, keyed by task DOT ID. Bridges maps
+// bridge names to URLs, and is only used when recording.
+type SimulationFixtures struct {
+	Bridges map[string]string            `json:"bridges,omitempty"`
+	Tasks   map[string]SimulationFixture `json:"tasks"`
+}
+
+// SimulationFixture is the recorded result of a single task.
+type SimulationFixture struct {
+	Value any     `json:"value,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+func (f SimulationFixture) result() Result {
+	if f.Error != nil {
+		return Result{Error: errors.New(*f.Error)}
+	}
+	return Result{Value: f.Value}
+}
+
+// SimulatedTaskResult is the outcome of a single task in a simulated run.
+type SimulatedTaskResult struct {
+	DotID string   `json:"dotID"`
+	Type  TaskType `json:"type"`
+	Value any      `json:"value"`
+	Error *string  `json:"error"`
+}
+
+// Simulator executes pipelines in-memory, without a database or live chains.
+// The results of http, bridge and ethcall tasks are substituted from
+// fixtures. In record mode, http and bridge tasks without a fixture are
+// executed for real and their results are added to the fixtures.
+type Simulator struct {
+	runner *runner
+	record bool
+
+	mu       sync.RWMutex
+	fixtures *SimulationFixtures
+}
+
+// NewSimulator returns a Simulator serving results from fixtures. A nil
+// fixtures value is treated as empty.
+func NewSimulator(cfg Config, fixtures *SimulationFixtures, record bool, lggr logger.Logger, httpClient *http.Client) *Simulator {
+	if fixtures == nil {
+		fixtures = &SimulationFixtures{}
+	}
+	if fixtures.Tasks == nil {
+		fixtures.Tasks = make(map[string]SimulationFixture)
+	}
+	lggr = lggr.Named("PipelineSimulator")
+	s := &Simulator{record: record, fixtures: fixtures}
+	s.runner = &runner{
+		btORM:                  &simulatorBridgeORM{s: s},
+		config:                 cfg,
+		bridgeConfig:           simulatorBridgeConfig{},
+		chStop:                 make(chan struct{}),
+		runFinished:            func(*Run) {},
+		lggr:                   lggr,
+		httpClient:             httpClient,
+		unrestrictedHTTPClient: httpClient,
+		tracer:                 otel.Tracer(tracerName),
+		wrapTask:               s.wrapTask,
+	}
+	return s
+}
+
+// Fixtures returns the fixtures, including any recorded during simulation.
+func (s *Simulator) Fixtures() *SimulationFixtures {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.fixtures
+}
+
+// Simulate parses spec.DotDagSource and executes it with vars, returning the
+// finished run and the results of all tasks.
+func (s *Simulator) Simulate(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error) {
+	// always parse a fresh copy, since its tasks are wrapped by wrapTask
+	spec.Pipeline = nil
+	p, err := s.runner.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := NewRun(spec, vars)
+	trrs := s.runner.run(ctx, p, run, vars)
+	if run.Pending {
+		return run, nil, errors.Errorf("unexpected async run for spec, async bridges cannot be simulated")
+	}
+	return run, trrs, nil
+}
+
+// SimulatedTaskResults converts trrs to a stable, serializable form ordered by task ID.
+func SimulatedTaskResults(trrs TaskRunResults) []SimulatedTaskResult {
+	sorted := make(TaskRunResults, len(trrs))
+	copy(sorted, trrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Task.ID() < sorted[j].Task.ID()
+	})
+
+	results := make([]SimulatedTaskResult, 0, len(sorted))
+	for _, trr := range sorted {
+		res := SimulatedTaskResult{
+			DotID: trr.Task.DotID(),
+			Type:  trr.Task.Type(),
+			Value: trr.Result.OutputDB().Val,
+		}
+		if errString := trr.Result.ErrorDB(); errString.Valid {
+			res.Error = &errString.String
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func (s *Simulator) fixture(dotID string) (SimulationFixture, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, ok := s.fixtures.Tasks[dotID]
+	return f, ok
+}
+
+func (s *Simulator) recordFixture(dotID string, result Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var f SimulationFixture
+	if result.Error != nil {
+		errString := result.Error.Error()
+		f.Error = &errString
+	} else {
+		f.Value = result.Value
+	}
+	s.fixtures.Tasks[dotID] = f
+}
+
+func (s *Simulator) bridgeURL(name bridges.BridgeName) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.fixtures.Bridges[string(name)]
+	return u, ok
+}
+
+// wrapTask substitutes fixtures for the results of the network-bound tasks,
+// including those in the body of foreach tasks.
+func (s *Simulator) wrapTask(task Task) Task {
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall:
+		return &simulatedTask{Task: task, s: s}
+	default:
+		return task
+	}
+}
+
+// simulatedTask substitutes the result of the wrapped task with its fixture.
+type simulatedTask struct {
+	Task
+	s *Simulator
+}
+
+func (t *simulatedTask) Run(ctx context.Context, lggr commonlogger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	if f, ok := t.s.fixture(t.DotID()); ok {
+		return f.result(), RunInfo{}
+	}
+	if !t.s.record || t.Type() == TaskTypeETHCall {
+		return Result{Error: errors.Wrapf(ErrNoSimulationFixture, "%s (%s)", t.DotID(), t.Type())}, RunInfo{}
+	}
+
+	result, runInfo := t.Task.Run(ctx, lggr, vars, inputs)
+	// retryable errors are not recorded, so that a later attempt may succeed
+	if !runInfo.IsPending && (result.Error == nil || !runInfo.IsRetryable) {
+		t.s.recordFixture(t.DotID(), result)
+	}
+	return result, runInfo
+}
+
+// simulatorBridgeORM resolves bridge URLs from the simulation fixtures.
+type simulatorBridgeORM struct {
+	bridges.ORM
+	s *Simulator
+}
+
+func (o *simulatorBridgeORM) FindBridge(_ context.Context, name bridges.BridgeName) (bt bridges.BridgeType, err error) {
+	rawURL, ok := o.s.bridgeURL(name)
+	if !ok {
+		return bt, sql.ErrNoRows
+	}
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return bt, errors.Wrapf(err, "invalid URL for bridge %s", name)
+	}
+	bt.Name = name
+	bt.URL = models.WebURL(*u)
+	return bt, nil
+}
+
+func (o *simulatorBridgeORM) GetCachedResponse(context.Context, string, int32, time.Duration) ([]byte, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (o *simulatorBridgeORM) UpsertBridgeResponse(context.Context, string, int32, []byte) error {
+	return nil
+}
+
+type simulatorBridgeConfig struct{}
+
+func (simulatorBridgeConfig) BridgeResponseURL() *url.URL   { return nil }
+func (simulatorBridgeConfig) BridgeCacheTTL() time.Duration { return 0 }
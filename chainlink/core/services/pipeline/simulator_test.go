@@ -0,0 +1,99 @@
+package pipeline_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const simulatorDAG = `
+ds1          [type=http method=GET url="https://example.invalid/price"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds2          [type=bridge name=price_adapter];
+ds2_parse    [type=jsonparse path="data,result"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`
+
+func TestSimulator_Fixtures(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	fixtures := &pipeline.SimulationFixtures{
+		Tasks: map[string]pipeline.SimulationFixture{
+			"ds1": {Value: `{"data":{"result":10}}`},
+			"ds2": {Value: `{"data":{"result":20}}`},
+		},
+	}
+	s := pipeline.NewSimulator(cfg.JobPipeline(), fixtures, false, logger.TestLogger(t), http.DefaultClient)
+
+	run, trrs, err := s.Simulate(testutils.Context(t), pipeline.Spec{DotDagSource: simulatorDAG}, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.False(t, run.HasErrors())
+
+	results := pipeline.SimulatedTaskResults(trrs)
+	require.Len(t, results, 5)
+	assert.Equal(t, "answer", results[len(results)-1].DotID)
+	assert.Equal(t, "15", trrs.FinalResult().Values[0].(decimal.Decimal).String())
+}
+
+func TestSimulator_MissingFixture(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	s := pipeline.NewSimulator(cfg.JobPipeline(), nil, false, logger.TestLogger(t), http.DefaultClient)
+
+	_, trrs, err := s.Simulate(testutils.Context(t), pipeline.Spec{DotDagSource: simulatorDAG}, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+
+	for _, trr := range trrs {
+		if trr.Task.DotID() == "ds1" || trr.Task.DotID() == "ds2" {
+			require.ErrorIs(t, trr.Result.Error, pipeline.ErrNoSimulationFixture)
+		}
+	}
+}
+
+func TestSimulator_Record(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"result":30}}`))
+	}))
+	defer server.Close()
+
+	dag := `
+ds1          [type=http method=GET url="` + server.URL + `"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds2          [type=bridge name=price_adapter];
+ds2_parse    [type=jsonparse path="data,result"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	fixtures := &pipeline.SimulationFixtures{Bridges: map[string]string{"price_adapter": server.URL}}
+	s := pipeline.NewSimulator(cfg.JobPipeline(), fixtures, true, logger.TestLogger(t), server.Client())
+
+	_, _, err := s.Simulate(testutils.Context(t), pipeline.Spec{DotDagSource: dag}, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+
+	recorded := s.Fixtures()
+	require.Contains(t, recorded.Tasks, "ds1")
+	require.Contains(t, recorded.Tasks, "ds2")
+	assert.JSONEq(t, `{"data":{"result":30}}`, recorded.Tasks["ds1"].Value.(string))
+	assert.JSONEq(t, `{"data":{"result":30}}`, recorded.Tasks["ds2"].Value.(string))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	stderrors "errors"
+	"fmt"
 	"maps"
 	"net/http"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -50,8 +52,48 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeFailovers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_failovers_total",
+		Help: "Bridge failovers to the next endpoint count scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeSchemaValidations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_schema_validations_total",
+		Help: "Bridge request and response schema validation count scoped by name, schema and result",
+	},
+		[]string{"name", "schema", "result"},
+	)
 )
 
+// ErrBridgeRequestSchema is returned when the request to a bridge does not match its request schema.
+type ErrBridgeRequestSchema struct {
+	Bridge string
+	Err    error
+}
+
+func (err ErrBridgeRequestSchema) Error() string {
+	return fmt.Sprintf("request to bridge %q does not match its requestSchema: %v", err.Bridge, err.Err)
+}
+
+func (err ErrBridgeRequestSchema) Unwrap() error {
+	return err.Err
+}
+
+// ErrBridgeResponseSchema is returned when the response of a bridge does not match its response schema.
+type ErrBridgeResponseSchema struct {
+	Bridge string
+	Err    error
+}
+
+func (err ErrBridgeResponseSchema) Error() string {
+	return fmt.Sprintf("response of bridge %q does not match its responseSchema: %v", err.Bridge, err.Err)
+}
+
+func (err ErrBridgeResponseSchema) Unwrap() error {
+	return err.Err
+}
+
 // Return types:
 //
 //	string
@@ -64,12 +106,29 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
+	// Coalesce enables sharing a single external adapter call between concurrent identical requests to the same
+	// bridge, across all jobs on the node.
+	Coalesce string `json:"coalesce"`
 
 	specId       int32
 	orm          bridges.ORM
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+
+	circuitBreakers *circuitBreakers
+	coalescer       *bridgeCoalescer
+	bridgeHealth    *bridges.HealthChecker
+}
+
+// bridgeFetchResult is shared between coalesced requests.
+type bridgeFetchResult struct {
+	url           URLParam
+	responseBytes []byte
+	statusCode    int
+	headers       http.Header
+	start, finish time.Time
+	err           error
 }
 
 type BridgeTelemetry struct {
@@ -84,6 +143,7 @@ type BridgeTelemetry struct {
 	SpecID                 int32     `json:"specID"`
 	ResponseStatusCode     int       `json:"responseStatusCode"`
 	LocalCacheHit          bool      `json:"localCacheHit"`
+	SharedResponse         bool      `json:"sharedResponse"`
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -106,6 +166,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		coalesce          BoolParam
 	)
 	err = stderrors.Join(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -113,6 +174,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&coalesce, From(NonemptyString(t.Coalesce), false)), "coalesce"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -125,10 +187,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, urls, err := t.getBridgeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := urls[0]
 
 	var metaMap MapParam
 
@@ -167,6 +230,13 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	if bt.RequestSchema != "" {
+		err = bt.ValidateRequest(requestDataJSON)
+		recordSchemaValidation(t.Name, "request", err)
+		if err != nil {
+			return Result{Error: ErrBridgeRequestSchema{Bridge: t.Name, Err: err}}, runInfo
+		}
+	}
 	logger.Sugared(lggr).Tracew("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
@@ -175,8 +245,65 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		sharedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		start, finish  time.Time
+	)
+	breakerKey := bridgeCircuitKey(string(name))
+	fetch := func(fetchCtx context.Context) (any, error) {
+		var (
+			res    bridgeFetchResult
+			failed bool
+		)
+		// fail over to the next endpoint as long as the request might succeed on another one
+		for i, endpoint := range urls {
+			res = bridgeFetchResult{url: endpoint}
+			res.responseBytes, res.statusCode, res.headers, res.start, res.finish, res.err = makeHTTPRequest(fetchCtx, lggr, "POST", endpoint, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+			// check for external adapter response object status
+			if code, ok := eautils.BestEffortExtractEAStatus(res.responseBytes); ok {
+				res.statusCode = code
+			}
+			failed = (res.err != nil || res.statusCode != http.StatusOK) && isRetryableHTTPError(res.statusCode, res.err)
+			if !failed || i == len(urls)-1 || fetchCtx.Err() != nil {
+				break
+			}
+			promBridgeFailovers.WithLabelValues(t.Name).Inc()
+			lggr.Debugw("Bridge task: request failed, failing over to the next endpoint",
+				"url", endpoint.String(),
+				"status_code", res.statusCode,
+				"error", res.err,
+			)
+		}
+		// recorded once per external adapter call, not once per coalesced waiter
+		t.circuitBreakers.record(breakerKey, failed)
+		return res, nil
+	}
+	if err = t.circuitBreakers.allow(breakerKey); err == nil {
+		var v any
+		if coalesce {
+			v, sharedResponse, err = t.coalescer.do(requestCtx, bridgeCoalesceKey(string(name), reqHeaders, requestDataJSON), t.config.DefaultHTTPTimeout().Duration(), fetch)
+			if sharedResponse {
+				promBridgeCoalesced.WithLabelValues(t.Name).Inc()
+			}
+		} else {
+			v, _ = fetch(requestCtx)
+		}
+		res, ok := v.(bridgeFetchResult)
+		if !ok {
+			// the caller stopped waiting for the coalesced request
+			res = bridgeFetchResult{url: url, err: err}
+		}
+		url = res.url
+		responseBytes, statusCode, headers, start, finish, err = res.responseBytes, res.statusCode, res.headers, res.start, res.finish, res.err
+	}
+	traceHTTPRequest(ctx, url, statusCode,
+		attribute.String("pipeline.bridge.name", t.Name),
+		attribute.Bool("pipeline.bridge.shared_response", sharedResponse),
+	)
 	elapsed := finish.Sub(start)
 	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
@@ -191,6 +318,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 				RequestStartTimestamp:  start,
 				RequestFinishTimestamp: finish,
 				LocalCacheHit:          cachedResponse,
+				SharedResponse:         sharedResponse,
 				SpecID:                 t.specId,
 				DotID:                  t.DotID(),
 			}
@@ -209,11 +337,6 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}()
 
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
-	}
-
 	if err != nil || statusCode != http.StatusOK {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
 			err = adapterErr
@@ -264,6 +387,22 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
+	// a response which does not match the schema is neither cached nor returned
+	if bt.ResponseSchema != "" {
+		// assigned to err so that the failure is reported in the telemetry
+		err = bt.ValidateResponse(responseBytes)
+		recordSchemaValidation(t.Name, "response", err)
+		if err != nil {
+			lggr.Debugw("Bridge task: response does not match responseSchema",
+				"response", string(responseBytes),
+				"url", url.String(),
+				"cached", cachedResponse,
+				"err", err,
+			)
+			return Result{Error: ErrBridgeResponseSchema{Bridge: t.Name, Err: err}}, runInfo
+		}
+	}
+
 	if !cachedResponse && cacheTTL > 0 {
 		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
 		if err != nil {
@@ -285,6 +424,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 		"dotID", t.DotID(),
 		"cached", cachedResponse,
+		"shared", sharedResponse,
 	)
 	return result, runInfo
 }
@@ -305,12 +445,26 @@ func (bt *BridgeTelemetry) resolveStreamID(t *BridgeTask, vars Vars, lggr logger
 	}
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+// getBridgeFromName returns the bridge and its endpoints, in the order they should be tried.
+func (t *BridgeTask) getBridgeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, []URLParam, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, nil, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	endpoints := t.bridgeHealth.Endpoints(bt)
+	urls := make([]URLParam, len(endpoints))
+	for i, endpoint := range endpoints {
+		urls[i] = URLParam(endpoint)
+	}
+	return bt, urls, nil
+}
+
+func recordSchemaValidation(name, schema string, err error) {
+	result := "valid"
+	if err != nil {
+		result = "invalid"
 	}
-	return URLParam(bt.URL), nil
+	promBridgeSchemaValidations.WithLabelValues(name, schema, result).Inc()
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
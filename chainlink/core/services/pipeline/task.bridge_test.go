@@ -1245,3 +1245,185 @@ ds [type=bridge name="adapter-error-bridge" timeout="50ms" requestData="{\"data\
 	require.ErrorContains(t, finalResult.Result.Error, "AdapterLWBAError: bid ask violation detected")
 	require.Nil(t, finalResult.Result.Value)
 }
+
+func TestBridgeTask_Coalesce(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	release := make(chan struct{})
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"data":{"result":9700}}`))
+	}))
+	defer s1.Close()
+
+	feedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: feedURL.String()})
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *sqlutil.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	const runs = 3
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+		Coalesce:    "true",
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+	task.HelperSetCoalescer()
+
+	telemCh := make(chan any, runs)
+	ctx := pipeline.WithTelemetryCh(testutils.Context(t), telemCh)
+	results := make(chan pipeline.Result, runs)
+	for range runs {
+		go func() {
+			result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			results <- result
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	for range runs {
+		result := <-results
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+
+		telem := <-telemCh
+		require.IsType(t, &pipeline.BridgeTelemetry{}, telem)
+		assert.True(t, telem.(*pipeline.BridgeTelemetry).SharedResponse)
+	}
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestBridgeTask_Failover(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	newServer := func(status int, body string) (*httptest.Server, *atomic.Int32) {
+		var requests atomic.Int32
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(s.Close)
+		return s, &requests
+	}
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *sqlutil.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	run := func(t *testing.T, primary, fallback *httptest.Server) pipeline.Result {
+		ctx := testutils.Context(t)
+		orm := bridges.NewORM(db)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: primary.URL})
+		require.NoError(t, orm.UpdateBridgeType(ctx, bridge, &bridges.BridgeTypeRequest{
+			URL:          bridge.URL,
+			FallbackURLs: bridges.WebURLs{cltest.WebURL(t, fallback.URL)},
+		}))
+
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("fails over to the next endpoint", func(t *testing.T) {
+		primary, primaryRequests := newServer(http.StatusServiceUnavailable, `{"error":"unavailable"}`)
+		fallback, fallbackRequests := newServer(http.StatusOK, `{"data":{"result":9700}}`)
+
+		result := run(t, primary, fallback)
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+		assert.Equal(t, int32(1), primaryRequests.Load())
+		assert.Equal(t, int32(1), fallbackRequests.Load())
+	})
+
+	t.Run("does not fail over on client errors", func(t *testing.T) {
+		primary, primaryRequests := newServer(http.StatusBadRequest, `{"error":"bad request"}`)
+		fallback, fallbackRequests := newServer(http.StatusOK, `{"data":{"result":9700}}`)
+
+		result := run(t, primary, fallback)
+		require.Error(t, result.Error)
+		assert.Equal(t, int32(1), primaryRequests.Load())
+		assert.Equal(t, int32(0), fallbackRequests.Load())
+	})
+}
+
+func TestBridgeTask_Schemas(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(testutils.Context(t), pipeline.Pipeline{}, *sqlutil.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+
+	run := func(t *testing.T, response string, btr bridges.BridgeTypeRequest) (pipeline.Result, *atomic.Int32) {
+		ctx := testutils.Context(t)
+		var requests atomic.Int32
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			_, _ = w.Write([]byte(response))
+		}))
+		t.Cleanup(s.Close)
+
+		orm := bridges.NewORM(db)
+		_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL})
+		btr.URL = bridge.URL
+		require.NoError(t, orm.UpdateBridgeType(ctx, bridge, &btr))
+
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result, &requests
+	}
+
+	responseSchema := `{"type": "object", "required": ["data"], "properties": {"data": {"type": "object", "required": ["result"], "properties": {"result": {"type": "number"}}}}}`
+
+	t.Run("valid response", func(t *testing.T) {
+		result, _ := run(t, `{"data":{"result":9700}}`, bridges.BridgeTypeRequest{ResponseSchema: responseSchema})
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		result, _ := run(t, `{"data":{"result":"9700"}}`, bridges.BridgeTypeRequest{ResponseSchema: responseSchema})
+		require.Error(t, result.Error)
+		var schemaErr pipeline.ErrBridgeResponseSchema
+		require.ErrorAs(t, result.Error, &schemaErr)
+		assert.Contains(t, schemaErr.Err.Error(), "expected number")
+		assert.Nil(t, result.Value)
+	})
+
+	t.Run("invalid request is not sent", func(t *testing.T) {
+		result, requests := run(t, `{"data":{"result":9700}}`, bridges.BridgeTypeRequest{RequestSchema: `{"type": "object", "required": ["apiKey"]}`})
+		require.Error(t, result.Error)
+		var schemaErr pipeline.ErrBridgeRequestSchema
+		require.ErrorAs(t, result.Error, &schemaErr)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+}
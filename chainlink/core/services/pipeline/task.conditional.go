@@ -9,12 +9,18 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 )
 
-// ConditionalTask checks if data is false
-// for now this is all we need but in the future we can
-// expand this to handle more general conditional statements
+// ConditionalTask checks if data is false, or if expr is set, that the
+// expression evaluates to true, e.g.
+//
+//	check [type=conditional expr="$(price) > 0 && $(volume) >= 1000"]
+//
+// See parseExpression for the expression language.
 type ConditionalTask struct {
 	BaseTask `mapstructure:",squash"`
 	Data     string `json:"data"`
+	Expr     string `json:"expr"`
+
+	program exprNode
 }
 
 var _ Task = (*ConditionalTask)(nil)
@@ -23,7 +29,21 @@ func (t *ConditionalTask) Type() TaskType {
 	return TaskTypeConditional
 }
 
-func (t *ConditionalTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+// compile parses expr if it is set, so that invalid expressions are rejected
+// when the pipeline is parsed rather than when it runs.
+func (t *ConditionalTask) compile() (err error) {
+	if t.Expr == "" {
+		return nil
+	}
+	t.program, err = parseExpression(t.Expr)
+	return errors.Wrapf(err, "task %s", t.DotID())
+}
+
+func (t *ConditionalTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	if t.Expr != "" {
+		return t.runExpr(ctx, vars), runInfo
+	}
+
 	_, err := CheckInputs(inputs, 0, 1, 0)
 	if err != nil {
 		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
@@ -42,3 +62,25 @@ func (t *ConditionalTask) Run(_ context.Context, _ logger.Logger, vars Vars, inp
 	}
 	return Result{Value: true}, runInfo
 }
+
+func (t *ConditionalTask) runExpr(ctx context.Context, vars Vars) Result {
+	program := t.program
+	if program == nil {
+		var err error
+		if program, err = parseExpression(t.Expr); err != nil {
+			return Result{Error: errors.Wrap(err, "expr")}
+		}
+	}
+	value, err := program.eval(ctx, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}
+	}
+	satisfied, err := exprBool(value)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}
+	}
+	if !satisfied {
+		return Result{Error: errors.New("conditional was not satisfied")}
+	}
+	return Result{Value: true}
+}
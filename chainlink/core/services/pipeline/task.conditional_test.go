@@ -69,3 +69,43 @@ func TestConditionalTask(t *testing.T) {
 		})
 	}
 }
+
+func TestConditionalTask_Expr(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]any{
+		"price":  "1850.25",
+		"volume": float64(2500),
+	})
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"satisfied", "$(price) > 0 && $(volume) >= 1000", ""},
+		{"not satisfied", "$(price) > 2000", "conditional was not satisfied"},
+		{"not a bool", "$(price) + 1", "expected a bool"},
+		{"invalid", "$(price) >", "unexpected"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ConditionalTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expr:     test.expr,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{})
+
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != "" {
+				require.ErrorContains(t, result.Error, test.wantErr)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.True(t, result.Value.(bool))
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// ExprTask evaluates an expression over the pipeline's variables, e.g.
+//
+//	price [type=expr expression="($(a) * $(b) + $(c)) / max($(d), 1)"]
+//
+// See parseExpression for the expression language.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	string
+//	bool
+//	nil
+//	[]interface{}
+//	map[string]interface{}
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+
+	program exprNode
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+// compile parses the expression, so that invalid expressions are rejected
+// when the pipeline is parsed rather than when it runs.
+func (t *ExprTask) compile() (err error) {
+	t.program, err = parseExpression(t.Expression)
+	return errors.Wrapf(err, "task %s", t.DotID())
+}
+
+func (t *ExprTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	program := t.program
+	if program == nil {
+		if program, err = parseExpression(t.Expression); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+	value, err := program.eval(ctx, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
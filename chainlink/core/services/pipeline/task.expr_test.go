@@ -0,0 +1,145 @@
+package pipeline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]any{
+		"a":      "1.5",
+		"b":      float64(4),
+		"c":      int64(-2),
+		"d":      decimal.RequireFromString("0.5"),
+		"name":   "ETH/USD",
+		"tiny":   "1e-2000000000",
+		"prices": []any{"100", float64(101), int64(102)},
+		"data":   map[string]any{"price": "12.345", "ok": true, "nested": map[string]any{"key": "price"}},
+	})
+
+	tests := []struct {
+		name       string
+		expression string
+		want       any
+		wantErr    string
+	}{
+		{"arithmetic", "($(a) * $(b) + $(c)) / max($(d), 1)", decimal.NewFromInt(4), ""},
+		{"precedence", "1 + 2 * 3 - 4 / 2", decimal.NewFromInt(5), ""},
+		{"decimal math", "0.1 + 0.2", decimal.RequireFromString("0.3"), ""},
+		{"modulo", "7 % 3", decimal.NewFromInt(1), ""},
+		{"unary minus", "-$(c) * -1", decimal.NewFromInt(-2), ""},
+		{"exponent literal", "1.5e3", decimal.NewFromInt(1500), ""},
+		{"keypath", "$(data.price) * 1000", decimal.NewFromInt(12345), ""},
+		{"list index", "$(prices)[1] + $(prices.2)", decimal.NewFromInt(203), ""},
+		{"map index", "$(data)[$(data.nested.key)]", "12.345", ""},
+		{"comparison", "$(b) >= 4 && $(c) < 0", true, ""},
+		{"string comparison", "'abc' < 'abd'", true, ""},
+		{"equality", "$(b) == 4.0 && $(name) != 'BTC/USD' && null == null", true, ""},
+		{"equality of different types", "$(b) == '4'", false, ""},
+		{"negation", "!$(data.ok)", false, ""},
+		{"short circuit", "false && $(missing) || true", true, ""},
+		{"ternary", "$(c) > 0 ? 'up' : $(c) < 0 ? 'down' : 'flat'", "down", ""},
+		{"string concat", "lower($(name)) + \"-\" + string($(b))", "eth/usd-4", ""},
+		{"string functions", "startsWith($(name), 'ETH') && endsWith(upper('usd'), 'SD') && contains(trim(' x '), 'x')", true, ""},
+		{"list contains", "contains($(prices), 101)", true, ""},
+		{"len", "len($(name)) + len($(prices)) + len($(data))", decimal.NewFromInt(13), ""},
+		{"rounding", "round(2 / 3, 4) + floor(1.7) + ceil(1.2) + abs(-1)", decimal.RequireFromString("4.6667"), ""},
+		{"pow", "pow(2, 10)", decimal.NewFromInt(1024), ""},
+		{"min of list", "min($(prices))", decimal.NewFromInt(100), ""},
+		{"decimal conversion", "decimal('1' + '0') * 2", decimal.NewFromInt(20), ""},
+
+		{"empty", "", nil, "unexpected"},
+		{"syntax error", "1 +", nil, "unexpected"},
+		{"unbalanced parentheses", "(1 + 2", nil, `expected ")"`},
+		{"unknown function", "exec('rm')", nil, "unknown function"},
+		{"wrong number of arguments", "abs(1, 2)", nil, "wrong number of arguments"},
+		{"unterminated string", "'abc", nil, "unterminated string"},
+		{"missing variable", "$(missing) + 1", nil, "keypath not found"},
+		{"type mismatch", "$(name) * 2", nil, "expected a number"},
+		{"not a bool", "1 && true", nil, "expected a bool"},
+		{"divide by zero", "1 / ($(b) - 4)", nil, "divide by zero"},
+		{"index out of range", "$(prices)[3]", nil, "index out of range"},
+		{"exponent too large", "pow(10, 1000)", nil, "exponent must be between"},
+		{"precision is limited", "pow(0.1, 100) == 0 && pow(0.1, 60) > 0", true, ""},
+		{"power too large", "pow(pow(10, 200), 2)", nil, "more than 256 integer digits"},
+		{"product too large", "pow(10, 200) * pow(10, 200)", nil, "more than 256 integer digits"},
+		{"literal scale too large", "1e-2000000000 + 1", nil, "more than 4160 decimal places"},
+		{"literal too large", "1e2000000000", nil, "more than 256 integer digits"},
+		{"variable scale too large", "$(tiny) * 2", nil, "more than 4160 decimal places"},
+		{"round places out of range", "round(1, 100000)", nil, "places must be between"},
+		{"too long", strings.Repeat("1+", 2048) + "1", nil, "longer than"},
+		{"too deep", strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100), nil, "nested deeper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: tt.expression,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, result.Error, tt.wantErr)
+				return
+			}
+			require.NoError(t, result.Error)
+			if want, ok := tt.want.(decimal.Decimal); ok {
+				require.IsType(t, decimal.Decimal{}, result.Value)
+				assert.True(t, want.Equal(result.Value.(decimal.Decimal)), "expected %v, got %v", want, result.Value)
+			} else {
+				assert.Equal(t, tt.want, result.Value)
+			}
+		})
+	}
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		for _, expression := range []string{"1 + 1", "max($(prices))", "contains($(prices), 1)"} {
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: expression,
+			}
+			result, _ := task.Run(ctx, logger.TestLogger(t), vars, nil)
+			require.ErrorIs(t, result.Error, context.Canceled, expression)
+		}
+	})
+}
+
+func TestExprTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+		a [type=memo value=3];
+		b [type=memo value=4];
+		hyp [type=expr expression="$(a) * $(a) + $(b) * $(b)"];
+	`)
+	require.NoError(t, err)
+
+	var hyp pipeline.Task
+	for _, task := range p.Tasks {
+		if task.DotID() == "hyp" {
+			hyp = task
+		}
+	}
+	require.NotNil(t, hyp)
+	var inputs []string
+	for _, input := range hyp.Inputs() {
+		inputs = append(inputs, input.InputTask.DotID())
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, inputs)
+}
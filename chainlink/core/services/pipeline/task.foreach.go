@@ -0,0 +1,182 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// ForeachTask runs Body, a sub-pipeline written in the same DOT language, once
+// for each element of Values. Within the body, $(item) is the current element
+// and $(index) its position. All variables of the enclosing pipeline are also
+// available, but the body's task names must not collide with them. A body
+// quoted in angle brackets cannot contain explicit edges, since their ">"
+// would end the quote; dependencies between its tasks are implied by their
+// variables instead, e.g. input="$(fetch)".
+//
+// Each iteration is run by its own scheduler, so task retries, timeouts and
+// failEarly apply to the tasks of every iteration independently. The body must
+// have exactly one terminal task, whose results are collected in order.
+//
+// Iterations run concurrently, up to Concurrency at a time (default
+// DefaultForeachConcurrency, at most MaxForeachConcurrency), each with its own
+// copy of the body tasks. Values must have at most MaxForeachValues elements.
+// Failed iterations count as faults; if no more than AllowedFaults (default 0)
+// iterations fail, their results are nil.
+//
+// Return types:
+//
+//	[]any
+type ForeachTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Body          string `json:"body"`
+	Concurrency   string `json:"concurrency"`
+	AllowedFaults string `json:"allowedFaults"`
+
+	body    *Pipeline
+	runBody func(ctx context.Context, vars Vars) (Result, error)
+}
+
+const (
+	// DefaultForeachConcurrency is the number of iterations a foreach task runs
+	// at a time if its concurrency is not set.
+	DefaultForeachConcurrency = 10
+	// MaxForeachConcurrency bounds the concurrency of a foreach task.
+	MaxForeachConcurrency = 100
+	// MaxForeachValues bounds the number of iterations of a foreach task.
+	MaxForeachValues = 1000
+)
+
+var _ Task = (*ForeachTask)(nil)
+
+func (t *ForeachTask) Type() TaskType {
+	return TaskTypeForeach
+}
+
+// parseBody parses and validates the body sub-pipeline.
+func (t *ForeachTask) parseBody() error {
+	body, err := t.newBody()
+	if err != nil {
+		return err
+	}
+
+	var terminal int
+	for _, task := range body.Tasks {
+		if len(task.Outputs()) == 0 {
+			terminal++
+		}
+	}
+	if terminal != 1 {
+		return errors.Errorf("foreach task %s: body must have exactly one terminal task, got %d", t.DotID(), terminal)
+	}
+	// sub-pipeline task runs are not persisted, so tasks which need to be are not supported
+	if body.RequiresPreInsert() {
+		return errors.Errorf("foreach task %s: body must not contain async bridge or ethtx tasks", t.DotID())
+	}
+	t.body = body
+	return nil
+}
+
+// newBody returns a fresh copy of the body sub-pipeline, so that no task is
+// shared between concurrent iterations.
+func (t *ForeachTask) newBody() (*Pipeline, error) {
+	source := strings.TrimSpace(t.Body)
+	// angle brackets are only stripped by the parser if the body contains no others
+	if strings.HasPrefix(source, "<") && strings.HasSuffix(source, ">") {
+		source = source[1 : len(source)-1]
+	}
+	body, err := Parse(source)
+	if err != nil {
+		return nil, errors.Wrapf(err, "foreach task %s: body", t.DotID())
+	}
+	return body, nil
+}
+
+func (t *ForeachTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		values             SliceParam
+		maybeConcurrency   MaybeUint64Param
+		maybeAllowedFaults MaybeUint64Param
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&maybeConcurrency, From(t.Concurrency)), "concurrency"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if t.runBody == nil {
+		return Result{Error: errors.Errorf("foreach task %s was not initialized", t.DotID())}, runInfo
+	}
+
+	if len(values) > MaxForeachValues {
+		return Result{Error: errors.Wrapf(ErrBadInput, "values must have at most %d elements, got %d", MaxForeachValues, len(values))}, runInfo
+	}
+	concurrency := DefaultForeachConcurrency
+	if c, isSet := maybeConcurrency.Uint64(); isSet {
+		if c == 0 || c > MaxForeachConcurrency {
+			return Result{Error: errors.Wrapf(ErrBadInput, "concurrency must be between 1 and %d", MaxForeachConcurrency)}, runInfo
+		}
+		concurrency = int(c) //nolint:gosec // G115
+	}
+	concurrency = min(concurrency, len(values))
+	allowedFaults, _ := maybeAllowedFaults.Uint64()
+
+	results := make([]any, len(values))
+	errs := make([]error, len(values))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(concurrency, 1))
+iterations:
+	for i, value := range values {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			// the iterations which were not started fail with the context
+			for j := i; j < len(values); j++ {
+				errs[j] = errors.Wrapf(ctx.Err(), "iteration %d", j)
+			}
+			break iterations
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			iterationVars := vars.Copy()
+			// the keys contain no separator, so Set cannot fail
+			_ = iterationVars.Set("item", value)
+			_ = iterationVars.Set("index", i)
+
+			res, err := t.runBody(ctx, iterationVars)
+			if err == nil {
+				err = res.Error
+			}
+			if err != nil {
+				errs[i] = errors.Wrapf(err, "iteration %d", i)
+				return
+			}
+			results[i] = res.Value
+		}()
+	}
+	wg.Wait()
+
+	var faults []error
+	for _, err := range errs {
+		if err != nil {
+			faults = append(faults, err)
+		}
+	}
+	if uint64(len(faults)) > allowedFaults {
+		lggr.Debugw("Foreach task: too many failed iterations", "dotID", t.DotID(), "errs", faults)
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of failed iterations %v of foreach task > number allowed faults %v: %v", len(faults), allowedFaults, stderrors.Join(faults...))}, runInfo
+	}
+	return Result{Value: results}, runInfo
+}
@@ -0,0 +1,159 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func newForeachTask(t *testing.T, attrs string) *pipeline.ForeachTask {
+	p, err := pipeline.Parse(fmt.Sprintf(`a [type=foreach values="$(values)" %s body=<b [type=lowercase input="$(item)"]>]`, attrs))
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 1)
+	return p.Tasks[0].(*pipeline.ForeachTask)
+}
+
+func TestForeachTask(t *testing.T) {
+	t.Parallel()
+
+	values := func(n int) []any {
+		v := make([]any, n)
+		for i := range v {
+			v[i] = fmt.Sprintf("V%d", i)
+		}
+		return v
+	}
+
+	tests := []struct {
+		name           string
+		attrs          string
+		values         []any
+		maxConcurrency int
+		wantErr        string
+	}{
+		{"default concurrency", "", values(50), pipeline.DefaultForeachConcurrency, ""},
+		{"set concurrency", "concurrency=3", values(50), 3, ""},
+		{"concurrency above the number of values", "concurrency=100", values(2), 2, ""},
+		{"no values", "", nil, 0, ""},
+		{"zero concurrency", "concurrency=0", values(2), 0, "concurrency must be between 1 and 100"},
+		{"concurrency above the maximum", "concurrency=101", values(2), 0, "concurrency must be between 1 and 100"},
+		{"too many values", "", values(pipeline.MaxForeachValues + 1), 0, "values must have at most 1000 elements"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := newForeachTask(t, test.attrs)
+			var (
+				mu                sync.Mutex
+				running, maxSeen  int
+				iterationsStarted int
+			)
+			task.HelperSetRunBody(func(ctx context.Context, vars pipeline.Vars) (pipeline.Result, error) {
+				mu.Lock()
+				running++
+				iterationsStarted++
+				maxSeen = max(maxSeen, running)
+				mu.Unlock()
+				defer func() {
+					mu.Lock()
+					running--
+					mu.Unlock()
+				}()
+
+				item, err := vars.Get("item")
+				if err != nil {
+					return pipeline.Result{}, err
+				}
+				return pipeline.Result{Value: item}, nil
+			})
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]any{"values": test.values}), nil)
+			if test.wantErr != "" {
+				require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+				require.ErrorContains(t, result.Error, test.wantErr)
+				assert.Zero(t, iterationsStarted)
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Len(t, result.Value, len(test.values))
+			for i, v := range test.values {
+				assert.Equal(t, v, result.Value.([]any)[i])
+			}
+			assert.LessOrEqual(t, maxSeen, test.maxConcurrency)
+		})
+	}
+
+	t.Run("iterations run their own copy of the body", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := configtest.NewTestGeneralConfig(t)
+		s := pipeline.NewSimulator(cfg.JobPipeline(), nil, false, logger.TestLogger(t), http.DefaultClient)
+		run, trrs, err := s.Simulate(testutils.Context(t), pipeline.Spec{DotDagSource: `
+a [type=foreach values="$(values)" concurrency=20 body=<
+	lower [type=lowercase input="$(item)"];
+	merge [type=merge left="{}" right=<{"index": $(index), "word": $(lower)}>];
+>]
+`}, pipeline.NewVarsFrom(map[string]any{"values": values(100)}))
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		require.Len(t, result.Value, 100)
+		for i, v := range result.Value.([]any) {
+			assert.Equal(t, map[string]any{"index": i, "word": fmt.Sprintf("v%d", i)}, v)
+		}
+	})
+	t.Run("canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		task := newForeachTask(t, "concurrency=1 allowedFaults=10")
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		var started int
+		task.HelperSetRunBody(func(ctx context.Context, vars pipeline.Vars) (pipeline.Result, error) {
+			started++
+			// the next iteration waits for this one to release its slot
+			cancel()
+			return pipeline.Result{Value: "done"}, nil
+		})
+
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(map[string]any{"values": values(20)}), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrTooManyErrors)
+		require.ErrorContains(t, result.Error, "context canceled")
+		assert.Less(t, started, 20)
+	})
+
+	t.Run("network-bound body tasks are simulated", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := configtest.NewTestGeneralConfig(t)
+		fixtures := &pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{"fetch": {Value: `{"result":"X"}`}},
+		}
+		s := pipeline.NewSimulator(cfg.JobPipeline(), fixtures, false, logger.TestLogger(t), http.DefaultClient)
+		run, trrs, err := s.Simulate(testutils.Context(t), pipeline.Spec{DotDagSource: `
+a [type=foreach values="$(values)" body=<
+	fetch [type=http method=GET url="https://example.invalid/$(item)"];
+	parse [type=jsonparse path="result" data="$(fetch)"];
+>]
+`}, pipeline.NewVarsFrom(map[string]any{"values": values(3)}))
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, []any{"X", "X", "X"}, result.Value)
+	})
+}
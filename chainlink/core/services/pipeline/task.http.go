@@ -2,9 +2,11 @@ package pipeline
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	stderrors "errors"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +26,21 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
-
+	// CacheTTL enables serving identical requests of all the jobs of the node from an in-memory cache.
+	CacheTTL string `json:"cacheTTL"`
+	// CacheKey overrides the cache key, which is otherwise derived from the method, URL, headers and request data.
+	// Tasks of any job setting the same key share a response.
+	CacheKey string `json:"cacheKey"`
+	// CacheFallback stores fetched responses in the database, and falls back to the last one stored if the request
+	// fails. It requires CacheTTL, which also bounds the age of the stored response.
+	CacheFallback string `json:"cacheFallback"`
+
+	orm                    ORM
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *circuitBreakers
+	httpCache              *httpResponseCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -47,6 +60,15 @@ var (
 	)
 )
 
+// httpFetchResult is shared between coalesced requests.
+type httpFetchResult struct {
+	responseBytes []byte
+	statusCode    int
+	respHeaders   http.Header
+	elapsed       int64
+	err           error
+}
+
 func (t *HTTPTask) Type() TaskType {
 	return TaskTypeHTTP
 }
@@ -63,6 +85,9 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
+		cacheKey                       StringParam
+		cacheFallback                  BoolParam
 	)
 	err = stderrors.Join(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +98,9 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
+		errors.Wrap(ResolveParam(&cacheKey, From(VarExpr(t.CacheKey, vars), t.CacheKey)), "cacheKey"),
+		errors.Wrap(ResolveParam(&cacheFallback, From(NonemptyString(t.CacheFallback), false)), "cacheFallback"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -94,6 +122,31 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
+	ttl := time.Duration(cacheTTL) * time.Second //nolint:gosec // G115
+	cacheFallbackEnabled := cacheTTL > 0 && bool(cacheFallback) && t.orm != nil
+	var key string
+	if cacheTTL > 0 {
+		if cacheKey == "" {
+			key = httpCacheKey(bool(allowUnrestrictedNetworkAccess), string(method), url.String(), reqHeaders, requestDataJSON)
+		} else {
+			key = httpCustomCacheKey(bool(allowUnrestrictedNetworkAccess), string(cacheKey))
+		}
+		if responseBytes, ok := t.httpCache.get(key); ok {
+			promHTTPCacheHits.Inc()
+			lggr.Debugw("HTTP task: serving cached response",
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+			return httpResult(responseBytes), runInfo
+		}
+		promHTTPCacheMisses.Inc()
+	}
+
+	breakerKey := httpCircuitKey(url.Host)
+	if err = t.circuitBreakers.allow(breakerKey); err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
@@ -103,28 +156,82 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
-	elapsed := finish.Sub(start).Milliseconds()
-	if err != nil {
+	fetch := func(fetchCtx context.Context) (any, error) {
+		responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(fetchCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		t.circuitBreakers.record(breakerKey, err != nil && isRetryableHTTPError(statusCode, err))
+		return httpFetchResult{responseBytes, statusCode, respHeaders, finish.Sub(start).Milliseconds(), err}, nil
+	}
+
+	var res httpFetchResult
+	if cacheTTL > 0 {
+		v, shared, doErr := t.httpCache.do(requestCtx, key, t.config.DefaultHTTPTimeout().Duration(), fetch)
+		if shared {
+			promHTTPCacheCoalesced.Inc()
+		}
+		if doErr != nil {
+			res = httpFetchResult{err: doErr}
+		} else {
+			res = v.(httpFetchResult)
+		}
+	} else {
+		v, _ := fetch(requestCtx)
+		res = v.(httpFetchResult)
+	}
+	traceHTTPRequest(ctx, url, res.statusCode)
+
+	if err = res.err; err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		if cacheFallbackEnabled {
+			overtimeCtx, cancel := overtimeContext(ctx)
+			defer cancel()
+			responseBytes, cacheErr := t.orm.FindHTTPResponse(overtimeCtx, key, ttl)
+			if cacheErr == nil {
+				promHTTPCacheFallbacks.Inc()
+				lggr.Debugw("HTTP task: request failed, falling back to cache",
+					"err", err,
+					"url", url.String(),
+				)
+				return Result{Value: string(responseBytes)}, runInfo
+			} else if !errors.Is(cacheErr, sql.ErrNoRows) {
+				lggr.Warnw("HTTP task: cache fallback failed",
+					"err", cacheErr.Error(),
+					"url", url.String(),
+				)
+			}
+		}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(res.statusCode, err)}
 	}
 
 	lggr.Debugw("HTTP task got response",
-		"response", string(responseBytes),
-		"respHeaders", respHeaders,
+		"response", string(res.responseBytes),
+		"respHeaders", res.respHeaders,
 		"url", url.String(),
 		"dotID", t.DotID(),
 	)
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(res.elapsed))
+	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(res.responseBytes)))
+
+	if cacheTTL > 0 {
+		t.httpCache.put(key, res.responseBytes, ttl)
+	}
+	if cacheFallbackEnabled {
+		overtimeCtx, cancel := overtimeContext(ctx)
+		defer cancel()
+		if err := t.orm.UpsertHTTPResponse(overtimeCtx, key, res.responseBytes); err != nil {
+			lggr.Errorw("HTTP task: failed to upsert response in cache", "err", err)
+		}
+	}
+	return httpResult(res.responseBytes), runInfo
+}
 
+// httpResult returns the response as the task result.
+func httpResult(responseBytes []byte) Result {
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
-	return Result{Value: string(responseBytes)}, runInfo
+	return Result{Value: string(responseBytes)}
 }
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -410,3 +411,65 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"result":9700}}`))
+	}))
+	defer s1.Close()
+
+	orm := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+
+	newTask := func(cacheFallback string) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:      pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:        "GET",
+			URL:           s1.URL,
+			CacheTTL:      "1m",
+			CacheFallback: cacheFallback,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(cfg.JobPipeline(), c, c)
+		task.HelperSetCache(orm)
+		return task
+	}
+
+	task := newTask("true")
+	for range 3 {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+	}
+	assert.Equal(t, int32(1), requests.Load())
+
+	// identical requests of other jobs share the cache
+	other := newTask("false")
+	other.HelperShareCache(task)
+	result, _ := other.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// without a cached response in memory, a failed request falls back to the database if enabled
+	failing.Store(true)
+	result, _ = newTask("true").Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+	assert.Equal(t, int32(2), requests.Load())
+
+	result, _ = newTask("false").Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	assert.Equal(t, int32(3), requests.Load())
+}
@@ -0,0 +1,164 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// OutlierFilterTask drops values which deviate too far from the median of all
+// values, either by more than MaxDeviations times the median absolute
+// deviation (MAD), or by more than MaxDeviationPercent of the median. Exactly
+// one of the two must be set. The MAD is at least minimumSpreadRatio of the
+// median, since it is zero when more than half of the values are equal.
+//
+// The remaining values are returned under "results", so they can be passed on
+// to e.g. a median task with values="$(filter.results)". The positions of the
+// rejected values within the input are returned under "rejected".
+//
+// Return types:
+//
+//	map[string]any{
+//	    "results": []any (decimal.Decimal),
+//	    "rejected": []any (int),
+//	}
+type OutlierFilterTask struct {
+	BaseTask            `mapstructure:",squash"`
+	Values              string `json:"values"`
+	AllowedFaults       string `json:"allowedFaults"`
+	MaxDeviations       string `json:"maxDeviations"`
+	MaxDeviationPercent string `json:"maxDeviationPercent"`
+	// Lax when disabled (default) will return an error if there are no values to filter or if the input includes nil values.
+	// Lax when enabled will return nil with no error if there are no valid values to filter. If the input includes nil values, they will be dropped and do not count as a fault.
+	Lax string
+}
+
+// minimumSpreadRatio bounds the MAD from below, relative to the median, so that
+// values are not rejected for deviations as small as rounding errors.
+var minimumSpreadRatio = decimal.New(1, -6)
+
+var _ Task = (*OutlierFilterTask)(nil)
+
+func (t *OutlierFilterTask) Type() TaskType {
+	return TaskTypeOutlierFilter
+}
+
+func (t *OutlierFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults  MaybeUint64Param
+		valuesAndErrs       SliceParam
+		maxDeviations       DecimalParam
+		maxDeviationPercent DecimalParam
+		allowedFaults       int
+		faults              int
+		lax                 BoolParam
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	switch {
+	case t.MaxDeviations != "" && t.MaxDeviationPercent != "":
+		return Result{Error: errors.Wrap(ErrBadInput, "maxDeviations and maxDeviationPercent cannot both be set")}, runInfo
+	case t.MaxDeviations != "":
+		err = errors.Wrap(ResolveParam(&maxDeviations, From(VarExpr(t.MaxDeviations, vars), t.MaxDeviations)), "maxDeviations")
+	case t.MaxDeviationPercent != "":
+		err = errors.Wrap(ResolveParam(&maxDeviationPercent, From(VarExpr(t.MaxDeviationPercent, vars), t.MaxDeviationPercent)), "maxDeviationPercent")
+	default:
+		return Result{Error: errors.Wrap(ErrBadInput, "one of maxDeviations or maxDeviationPercent must be set")}, runInfo
+	}
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if maxDeviations.Decimal().IsNegative() || maxDeviationPercent.Decimal().IsNegative() {
+		return Result{Error: errors.Wrap(ErrBadInput, "maximum deviation must not be negative")}, runInfo
+	}
+
+	// positions are tracked so that rejected values can be reported against the original input
+	var positions []int
+	var values SliceParam
+	for i, v := range valuesAndErrs {
+		if v == nil && bool(lax) {
+			// if lax is enabled, nil values are dropped and not included in the fault calculations
+			continue
+		}
+		if _, is := v.(error); is {
+			faults++
+			continue
+		}
+		positions = append(positions, i)
+		values = append(values, v)
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = max(len(values)+faults-1, 0)
+	}
+
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to outlier filter task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 && bool(lax) {
+		return Result{}, runInfo // if lax is enabled, return nil result with no error
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to filter")}, runInfo
+	}
+
+	var decimalValues DecimalSliceParam
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	median := medianOf(decimalValues)
+	deviations := make([]decimal.Decimal, len(decimalValues))
+	for i, v := range decimalValues {
+		deviations[i] = v.Sub(median).Abs()
+	}
+
+	var threshold decimal.Decimal
+	if t.MaxDeviations != "" {
+		spread := decimal.Max(medianOf(deviations), median.Abs().Mul(minimumSpreadRatio))
+		threshold = spread.Mul(maxDeviations.Decimal())
+	} else {
+		threshold = median.Abs().Mul(maxDeviationPercent.Decimal()).Div(decimal.NewFromInt(100))
+	}
+
+	results := []any{}
+	rejected := []any{}
+	for i, v := range decimalValues {
+		if deviations[i].GreaterThan(threshold) {
+			rejected = append(rejected, positions[i])
+		} else {
+			results = append(results, v)
+		}
+	}
+	return Result{Value: map[string]any{
+		"results":  results,
+		"rejected": rejected,
+	}}, runInfo
+}
+
+// medianOf returns the median of values without modifying them.
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
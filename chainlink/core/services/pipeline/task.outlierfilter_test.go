@@ -0,0 +1,186 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestOutlierFilterTask(t *testing.T) {
+	t.Parallel()
+
+	values := []pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "101")}, {Value: mustDecimal(t, "99")}, {Value: mustDecimal(t, "150")}, {Value: mustDecimal(t, "102")}}
+
+	tests := []struct {
+		name                string
+		inputs              []pipeline.Result
+		maxDeviations       string
+		maxDeviationPercent string
+		allowedFaults       string
+		lax                 string
+		wantResults         []string
+		wantRejected        []any
+		wantErr             error
+	}{
+		{
+			"MAD rejects outlier",
+			values,
+			"3", "", "", "",
+			[]string{"100", "101", "99", "102"},
+			[]any{3},
+			nil,
+		},
+		{
+			"MAD with a large multiple keeps everything",
+			values,
+			"100", "", "", "",
+			[]string{"100", "101", "99", "150", "102"},
+			[]any{},
+			nil,
+		},
+		{
+			"zero MAD keeps rounding errors",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100.00001")}, {Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100")}},
+			"3", "", "", "",
+			[]string{"100", "100", "100.00001", "100", "100"},
+			[]any{},
+			nil,
+		},
+		{
+			"zero MAD rejects outlier",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100.1")}, {Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "100")}},
+			"3", "", "", "",
+			[]string{"100", "100", "100", "100"},
+			[]any{2},
+			nil,
+		},
+		{
+			"identical values are all kept",
+			[]pipeline.Result{{Value: mustDecimal(t, "7")}, {Value: mustDecimal(t, "7")}, {Value: mustDecimal(t, "7")}},
+			"3", "", "", "",
+			[]string{"7", "7", "7"},
+			[]any{},
+			nil,
+		},
+		{
+			"percentage rejects outlier",
+			values,
+			"", "10", "", "",
+			[]string{"100", "101", "99", "102"},
+			[]any{3},
+			nil,
+		},
+		{
+			"percentage rejects everything outside the band",
+			values,
+			"", "1", "", "",
+			[]string{"100", "101", "102"},
+			[]any{2, 3},
+			nil,
+		},
+		{
+			"rejected positions include errored inputs",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "9")}},
+			"3", "", "1", "",
+			[]string{"1", "1"},
+			[]any{3},
+			nil,
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "1")}},
+			"3", "", "1", "",
+			nil, nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"neither threshold set",
+			values,
+			"", "", "", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"both thresholds set",
+			values,
+			"3", "10", "", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"negative threshold",
+			values,
+			"-1", "", "", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"3", "", "0", "",
+			nil, nil,
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"(unspecified Lax) error on parsing nil inputs",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "2")}},
+			"3", "", "", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"nil inputs with Lax enabled",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "20")}},
+			"3", "", "", "true",
+			[]string{"2", "2"},
+			[]any{3},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.OutlierFilterTask{
+				BaseTask:            pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				MaxDeviations:       test.maxDeviations,
+				MaxDeviationPercent: test.maxDeviationPercent,
+				AllowedFaults:       test.allowedFaults,
+				Lax:                 test.lax,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			m := output.Value.(map[string]any)
+			var results []string
+			for _, r := range m["results"].([]any) {
+				results = append(results, r.(decimal.Decimal).String())
+			}
+			assert.Equal(t, test.wantResults, results)
+			assert.Equal(t, test.wantRejected, m["rejected"])
+		})
+	}
+
+	t.Run("lax with no values", func(t *testing.T) {
+		task := pipeline.OutlierFilterTask{
+			BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			MaxDeviations: "3",
+			Lax:           "true",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{}, {}})
+		require.NoError(t, output.Error)
+		require.Nil(t, output.Value)
+	})
+}
@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Precision     string `json:"precision"`
+	// Trim is the number of values to drop from each end of the sorted values.
+	Trim string `json:"trim"`
+	// TrimPercent is the percentage of values to drop from each end of the sorted values, rounded down. It cannot be
+	// combined with Trim.
+	TrimPercent string `json:"trimPercent"`
+	// Lax when disabled (default) will return an error if there are no values to average or if the input includes nil values.
+	// Lax when enabled will return nil with no error if there are no valid values to average. If the input includes nil values, they will be excluded from the calculation and do not count as a fault.
+	Lax string
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		maybeTrim          MaybeUint64Param
+		maybeTrimPercent   MaybeUint64Param
+		valuesAndErrs      SliceParam
+		decimalValues      DecimalSliceParam
+		allowedFaults      int
+		faults             int
+		lax                BoolParam
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&maybeTrim, From(VarExpr(t.Trim, vars), t.Trim)), "trim"),
+		errors.Wrap(ResolveParam(&maybeTrimPercent, From(VarExpr(t.TrimPercent, vars), t.TrimPercent)), "trimPercent"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	trim, trimIsSet := maybeTrim.Uint64()
+	trimPercent, trimPercentIsSet := maybeTrimPercent.Uint64()
+	if trimIsSet && trimPercentIsSet {
+		return Result{Error: errors.Wrap(ErrBadInput, "trim and trimPercent cannot both be set")}, runInfo
+	} else if trimPercentIsSet && trimPercent >= 50 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trimPercent must be less than 50, got %v", trimPercent)}, runInfo
+	}
+
+	// if lax is enabled, filter out nil values
+	// nil values are not included in the fault calculations
+	if bool(lax) {
+		valuesAndErrs, _ = valuesAndErrs.FilterNils()
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = max(len(valuesAndErrs)-1, 0)
+	}
+
+	values, faults := valuesAndErrs.FilterErrors()
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to trimmed mean task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 && bool(lax) {
+		return Result{}, runInfo // if lax is enabled, return nil result with no error
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to average")}, runInfo
+	}
+
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if trimPercentIsSet {
+		trim = uint64(len(decimalValues)) * trimPercent / 100 //nolint:gosec // G115
+	}
+	if 2*trim >= uint64(len(decimalValues)) { //nolint:gosec // G115
+		return Result{Error: errors.Wrapf(ErrWrongInputCardinality, "cannot trim %v values from each end of %v values", trim, len(decimalValues))}, runInfo
+	}
+
+	sort.Slice(decimalValues, func(i, j int) bool {
+		return decimalValues[i].LessThan(decimalValues[j])
+	})
+	trimmed := decimalValues[trim : uint64(len(decimalValues))-trim] //nolint:gosec // G115
+
+	total := decimal.NewFromInt(0)
+	for _, val := range trimmed {
+		total = total.Add(val)
+	}
+
+	numValues := decimal.NewFromInt(int64(len(trimmed)))
+
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: total.DivRound(numValues, precision)}, runInfo
+	}
+	// Note that decimal library defaults to rounding to 16 precision
+	return Result{Value: total.Div(numValues)}, runInfo
+}
@@ -0,0 +1,143 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	fiveValues := []pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "4")}}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		trimPercent   string
+		allowedFaults string
+		precision     string
+		lax           string
+		want          pipeline.Result
+	}{
+		{
+			"no trim is a plain mean",
+			fiveValues,
+			"", "", "", "", "",
+			pipeline.Result{Value: mustDecimal(t, "22")},
+		},
+		{
+			"trim one from each end",
+			fiveValues,
+			"1", "", "", "", "",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"trim by percentage rounds down",
+			fiveValues,
+			"", "30", "", "", "",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"trim by percentage smaller than one value",
+			fiveValues,
+			"", "10", "", "", "",
+			pipeline.Result{Value: mustDecimal(t, "22")},
+		},
+		{
+			"precision",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "9")}},
+			"1", "", "", "2", "",
+			pipeline.Result{Value: mustDecimal(t, "1.5")},
+		},
+		{
+			"trimming every value",
+			fiveValues,
+			"3", "", "", "", "",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"trim and trimPercent",
+			fiveValues,
+			"1", "20", "", "", "",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"trimPercent of 50",
+			fiveValues,
+			"", "50", "", "", "",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"errors are excluded before trimming",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"1", "", "1", "", "",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}},
+			"", "", "1", "", "",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"", "", "0", "", "",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"(unspecified Lax) error on parsing nil inputs",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"", "", "", "", "",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"nil inputs with Lax enabled",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "6")}},
+			"1", "", "", "", "true",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"zero non-nil inputs with Lax enabled",
+			[]pipeline.Result{{}, {}},
+			"", "", "", "", "true",
+			pipeline.Result{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				TrimPercent:   test.trimPercent,
+				AllowedFaults: test.allowedFaults,
+				Precision:     test.precision,
+				Lax:           test.lax,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				if test.want.Value == nil {
+					require.Nil(t, output.Value)
+				} else {
+					require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// Return types:
+//
+//	*decimal.Decimal
+type WeightedMedianTask struct {
+	BaseTask `mapstructure:",squash"`
+	Values   string `json:"values"`
+	// Weights must have one non-negative weight per value, in the same order.
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+	// Lax when disabled (default) will return an error if there are no values to medianize or if the input includes nil values.
+	// Lax when enabled will return nil with no error if there are no valid values to medianize. If the input includes nil values, they will be excluded from the median calculation along with their weights and do not count as a fault.
+	Lax string
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		allowedFaults      int
+		faults             int
+		lax                BoolParam
+	)
+	err := stderrors.Join(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrWrongInputCardinality, "got %v weights for %v values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+
+	// if lax is enabled, filter out nil values and their weights
+	// nil values are not included in the fault calculations
+	if bool(lax) {
+		var filteredValues SliceParam
+		var filteredWeights DecimalSliceParam
+		for i, v := range valuesAndErrs {
+			if v != nil {
+				filteredValues = append(filteredValues, v)
+				filteredWeights = append(filteredWeights, weights[i])
+			}
+		}
+		valuesAndErrs, weights = filteredValues, filteredWeights
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = max(len(valuesAndErrs)-1, 0)
+	}
+
+	var values SliceParam
+	var valueWeights DecimalSliceParam
+	for i, v := range valuesAndErrs {
+		if _, is := v.(error); is {
+			faults++
+			continue
+		}
+		values = append(values, v)
+		valueWeights = append(valueWeights, weights[i])
+	}
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to weighted median task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 && bool(lax) {
+		return Result{}, runInfo // if lax is enabled, return nil result with no error
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to medianize")}, runInfo
+	}
+
+	var decimalValues DecimalSliceParam
+	err = decimalValues.UnmarshalPipelineParam(values)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	median, err := weightedMedian(decimalValues, valueWeights)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: median}, runInfo
+}
+
+// weightedMedian returns the value at which the cumulative weight of the sorted
+// values first reaches half of the total weight. If it lands exactly on half,
+// the two neighbouring values are averaged, so equal weights give the same
+// result as MedianTask.
+func weightedMedian(values, weights []decimal.Decimal) (decimal.Decimal, error) {
+	type weighted struct {
+		value, weight decimal.Decimal
+	}
+	pairs := make([]weighted, len(values))
+	total := decimal.Zero
+	for i := range values {
+		if weights[i].IsNegative() {
+			return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "weight %v is negative", weights[i])
+		}
+		pairs[i] = weighted{values[i], weights[i]}
+		total = total.Add(weights[i])
+	}
+	if !total.IsPositive() {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, "weights must not all be zero")
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].value.LessThan(pairs[j].value)
+	})
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.Zero
+	for i, p := range pairs {
+		cumulative = cumulative.Add(p.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			// average with the next value which carries any weight
+			for _, next := range pairs[i+1:] {
+				if next.weight.IsPositive() {
+					return p.value.Add(next.value).Div(decimal.NewFromInt(2)), nil
+				}
+			}
+		}
+		return p.value, nil
+	}
+	return pairs[len(pairs)-1].value, nil
+}
@@ -0,0 +1,182 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		lax           string
+		want          pipeline.Result
+	}{
+		{
+			"equal weights, odd number of inputs",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`[1, 1, 1]`,
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"equal weights, even number of inputs",
+			[]pipeline.Result{{Value: mustDecimal(t, "4")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "2")}},
+			`[1, 1, 1, 1]`,
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"heavy source dominates",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "10")}},
+			`[1, 1, 5]`,
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "10")},
+		},
+		{
+			"fractional weights",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`["0.6", "0.3", "0.1"]`,
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "1")},
+		},
+		{
+			"zero weight is skipped when averaging",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`[1, 0, 1]`,
+			"",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"errored values drop their weights",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`[10, 1, 3]`,
+			"1",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}},
+			`[1, 1, 1]`,
+			"1",
+			"",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"mismatched weights",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			`[1]`,
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"negative weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			`[1, -1]`,
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"all weights zero",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			`[0, 0]`,
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			`[]`,
+			"0",
+			"",
+			pipeline.Result{Error: pipeline.ErrWrongInputCardinality},
+		},
+		{
+			"(unspecified Lax) error on parsing nil inputs",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`[1, 1, 1]`,
+			"",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"nil inputs with Lax enabled drop their weights",
+			[]pipeline.Result{{}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			`[10, 1, 1]`,
+			"",
+			"true",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"zero non-nil inputs with Lax enabled",
+			[]pipeline.Result{{}, {}},
+			`[1, 1]`,
+			"",
+			"true",
+			pipeline.Result{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+				Lax:           test.lax,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				if test.want.Value == nil {
+					require.Nil(t, output.Value)
+				} else {
+					require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+				}
+			}
+		})
+	}
+
+	t.Run("with vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]any{
+			"foo": map[string]any{
+				"values":  []any{"1", "2", "10"},
+				"weights": []any{"1", "1", "5"},
+			},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(foo.values)",
+			Weights:  "$(foo.weights)",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, output.Error)
+		require.Equal(t, "10", output.Value.(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,102 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+
+// startRunSpan starts the span of a pipeline run. Task spans are its children.
+func startRunSpan(ctx context.Context, tracer trace.Tracer, run *Run) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "pipeline.run", trace.WithAttributes(
+		attribute.Int64("pipeline.run.id", run.ID),
+		attribute.Int("pipeline.spec.id", int(run.PipelineSpecID)),
+		attribute.String("job.id", strconv.Itoa(int(run.PipelineSpec.JobID))),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+	))
+}
+
+func endRunSpan(span trace.Span, run *Run) {
+	span.SetAttributes(
+		attribute.String("pipeline.run.state", string(run.State)),
+		attribute.Bool("pipeline.run.pending", run.Pending),
+	)
+	if run.HasFatalErrors() {
+		span.SetStatus(codes.Error, "run has fatal errors")
+	}
+	span.End()
+}
+
+// startTaskSpan starts the span of a single attempt of a task run.
+func startTaskSpan(ctx context.Context, tracer trace.Tracer, taskRun *memoryTaskRun) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "pipeline.task", trace.WithAttributes(
+		attribute.String("pipeline.task.dot_id", taskRun.task.DotID()),
+		attribute.String("pipeline.task.type", string(taskRun.task.Type())),
+		attribute.Int("pipeline.task.attempt", int(taskRun.attempts)), //nolint:gosec // G115
+	))
+}
+
+func endTaskSpan(span trace.Span, result Result, runInfo RunInfo) {
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.Int("pipeline.task.output_size", resultSize(result.Value)),
+			attribute.Bool("pipeline.task.pending", runInfo.IsPending),
+			attribute.Bool("pipeline.task.retryable", runInfo.IsRetryable),
+		)
+	}
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+	}
+	span.End()
+}
+
+// resultSize returns the size in bytes of a task's output, as JSON unless it
+// is already a string or bytes.
+func resultSize(value any) int {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case []byte:
+		return len(v)
+	case string:
+		return len(v)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return -1
+	}
+	return len(b)
+}
+
+// traceHTTPRequest records the outcome of a request made by a task on the
+// task's span. The URL's credentials and query are omitted, as they may
+// contain secrets.
+func traceHTTPRequest(ctx context.Context, u URLParam, statusCode int, attrs ...attribute.KeyValue) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	span.SetAttributes(append(attrs,
+		attribute.String("url.full", u.String()),
+		attribute.Int("http.response.status_code", statusCode),
+	)...)
+}
+
+// injectTraceHeaders propagates the trace context to external adapters and
+// other HTTP endpoints, so that their logs can be correlated with the run.
+func injectTraceHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
@@ -0,0 +1,100 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(prevPropagator) })
+
+	traceparents := make(chan string, 1)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents <- r.Header.Get("traceparent")
+		_, _ = w.Write([]byte(`{"price": 123.45}`))
+	}))
+	t.Cleanup(s.Close)
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(mocks.NewORM(t), bridgesMocks.NewORM(t), nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+	recorder := tracetest.NewSpanRecorder()
+	r.HelperSetTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"))
+
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+		JobID:   1,
+		JobName: "traced",
+		DotDagSource: fmt.Sprintf(`
+fetch [type=http method=GET url="%s?apiKey=secret"];
+parse [type=jsonparse path="price"];
+fail [type=fail msg="oops"];
+fetch -> parse;
+`, s.URL),
+	}, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.True(t, trrs.FinalResult().HasFatalErrors())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+	var runSpan sdktrace.ReadOnlySpan
+	taskSpans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range spans {
+		switch span.Name() {
+		case "pipeline.run":
+			runSpan = span
+		case "pipeline.task":
+			taskSpans[spanAttribute(span, "pipeline.task.dot_id").AsString()] = span
+		}
+	}
+	require.NotNil(t, runSpan)
+	assert.Equal(t, "traced", spanAttribute(runSpan, "job.name").AsString())
+	assert.Equal(t, "errored", spanAttribute(runSpan, "pipeline.run.state").AsString())
+	assert.Equal(t, codes.Error, runSpan.Status().Code)
+	require.Len(t, taskSpans, 3)
+	for _, span := range taskSpans {
+		assert.Equal(t, runSpan.SpanContext().SpanID(), span.Parent().SpanID())
+	}
+
+	fetch := taskSpans["fetch"]
+	assert.Equal(t, "http", spanAttribute(fetch, "pipeline.task.type").AsString())
+	assert.Equal(t, int64(200), spanAttribute(fetch, "http.response.status_code").AsInt64())
+	assert.Equal(t, s.URL, spanAttribute(fetch, "url.full").AsString())
+	assert.Equal(t, int64(len(`{"price": 123.45}`)), spanAttribute(fetch, "pipeline.task.output_size").AsInt64())
+	assert.Equal(t, codes.Unset, fetch.Status().Code)
+
+	traceparent := <-traceparents
+	assert.Contains(t, traceparent, fetch.SpanContext().TraceID().String())
+	assert.Contains(t, traceparent, fetch.SpanContext().SpanID().String())
+
+	assert.Equal(t, codes.Error, taskSpans["fail"].Status().Code)
+}
@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request, see Sign.
+	SignatureHeader = "X-Chainlink-Signature"
+	// TimestampHeader carries the unix time, in seconds, at which the request was signed.
+	TimestampHeader = "X-Chainlink-Timestamp"
+	// NonceHeader carries a value which must be unique per request to the same job.
+	NonceHeader = "X-Chainlink-Nonce"
+
+	// DefaultSignatureTolerance is used when the webhook spec does not set signatureTolerance.
+	DefaultSignatureTolerance = 5 * time.Minute
+
+	minSigningSecretLength = 16
+	maxNonceLength         = 128
+	requestSchemaURL       = "mem://webhook/requestSchema.json"
+)
+
+var (
+	ErrRequestSigningDisabled = errors.New("job does not accept signed requests")
+	ErrInvalidSignature       = errors.New("invalid request signature")
+	ErrStaleRequest           = errors.New("request timestamp is outside of the signature tolerance")
+	ErrReplayedRequest        = errors.New("request nonce has already been used")
+)
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<nonce>.<body>" keyed by secret.
+func Sign(secret string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RequestVerifier authenticates signed requests to run webhook jobs.
+type RequestVerifier struct {
+	ds sqlutil.DataSource
+}
+
+func NewRequestVerifier(ds sqlutil.DataSource) *RequestVerifier {
+	return &RequestVerifier{ds}
+}
+
+// Verify checks the signature and timestamp of a request to run jb, and consumes its nonce so that the
+// request cannot be replayed.
+func (v *RequestVerifier) Verify(ctx context.Context, jb job.Job, header http.Header, body []byte, now time.Time) error {
+	if jb.WebhookSpec == nil || !jb.WebhookSpec.SigningSecret.Valid {
+		return ErrRequestSigningDisabled
+	}
+	spec := jb.WebhookSpec
+
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "malformed %s header", TimestampHeader)
+	}
+	nonce := header.Get(NonceHeader)
+	if nonce == "" || len(nonce) > maxNonceLength {
+		return errors.Wrapf(ErrInvalidSignature, "%s header must be between 1 and %d characters long", NonceHeader, maxNonceLength)
+	}
+	expected, err := hex.DecodeString(Sign(spec.SigningSecret.String, timestamp, nonce, body))
+	if err != nil {
+		return err
+	}
+	actual, err := hex.DecodeString(strings.TrimPrefix(header.Get(SignatureHeader), "sha256="))
+	if err != nil || !hmac.Equal(expected, actual) {
+		return ErrInvalidSignature
+	}
+
+	tolerance := spec.SignatureTolerance
+	if tolerance == 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-tolerance)) || signedAt.After(now.Add(tolerance)) {
+		return ErrStaleRequest
+	}
+	return v.consumeNonce(ctx, jb.ExternalJobID, nonce, now, tolerance)
+}
+
+// consumeNonce records nonce for the job and fails if it was already recorded. Requests are accepted up to
+// tolerance before and after their timestamp, so nonces older than twice the tolerance can no longer be
+// replayed and are pruned.
+func (v *RequestVerifier) consumeNonce(ctx context.Context, externalJobID uuid.UUID, nonce string, now time.Time, tolerance time.Duration) error {
+	if _, err := v.ds.ExecContext(ctx, `DELETE FROM webhook_request_nonces WHERE external_job_id = $1 AND created_at < $2`,
+		externalJobID, now.Add(-2*tolerance)); err != nil {
+		return errors.Wrap(err, "failed to prune webhook request nonces")
+	}
+	res, err := v.ds.ExecContext(ctx, `INSERT INTO webhook_request_nonces (external_job_id, nonce, created_at)
+VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`, externalJobID, nonce, now)
+	if err != nil {
+		return errors.Wrap(err, "failed to record webhook request nonce")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to record webhook request nonce")
+	}
+	if n == 0 {
+		return ErrReplayedRequest
+	}
+	return nil
+}
+
+// ValidateRequestBody checks body against the request schema of spec, if it has one.
+func ValidateRequestBody(spec job.WebhookSpec, body []byte) error {
+	if !spec.RequestSchema.Valid {
+		return nil
+	}
+	schema, err := compileRequestSchema(spec.RequestSchema.String)
+	if err != nil {
+		return errors.Wrap(err, "invalid requestSchema")
+	}
+	var payload any
+	if err = json.Unmarshal(body, &payload); err != nil {
+		return errors.Wrap(err, "request body is not valid JSON")
+	}
+	return errors.Wrap(schema.Validate(payload), "request body does not match requestSchema")
+}
+
+// compileRequestSchema compiles a request schema, refusing to resolve any $ref outside of the schema itself
+// so that specs cannot make the node read local files or fetch remote documents.
+func compileRequestSchema(schema string) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, errors.Errorf("loading external schema %s is not allowed", url)
+	}
+	if err := c.AddResource(requestSchemaURL, strings.NewReader(schema)); err != nil {
+		return nil, err
+	}
+	return c.Compile(requestSchemaURL)
+}
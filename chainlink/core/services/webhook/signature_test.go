@@ -0,0 +1,99 @@
+package webhook_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+func signedHeader(secret string, timestamp time.Time, nonce string, body []byte) http.Header {
+	header := http.Header{}
+	header.Set(webhook.TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(webhook.NonceHeader, nonce)
+	header.Set(webhook.SignatureHeader, webhook.Sign(secret, timestamp.Unix(), nonce, body))
+	return header
+}
+
+func Test_RequestVerifier(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	verifier := webhook.NewRequestVerifier(db)
+	const secret = "0123456789abcdef"
+	body := []byte(`{"price": 42}`)
+	now := time.Now()
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	jb.WebhookSpec.SigningSecret = null.StringFrom(secret)
+
+	t.Run("accepts a signed request once", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		header := signedHeader(secret, now, "nonce-1", body)
+
+		require.NoError(t, verifier.Verify(ctx, jb, header, body, now))
+		require.ErrorIs(t, verifier.Verify(ctx, jb, header, body, now), webhook.ErrReplayedRequest)
+	})
+
+	t.Run("rejects a tampered body", func(t *testing.T) {
+		header := signedHeader(secret, now, "nonce-2", body)
+
+		err := verifier.Verify(testutils.Context(t), jb, header, []byte(`{"price": 43}`), now)
+		require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects the wrong secret", func(t *testing.T) {
+		header := signedHeader("fedcba9876543210", now, "nonce-3", body)
+
+		err := verifier.Verify(testutils.Context(t), jb, header, body, now)
+		require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects missing headers", func(t *testing.T) {
+		err := verifier.Verify(testutils.Context(t), jb, http.Header{}, body, now)
+		require.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects timestamps outside of the tolerance", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		stale := now.Add(-webhook.DefaultSignatureTolerance - time.Minute)
+		err := verifier.Verify(ctx, jb, signedHeader(secret, stale, "nonce-4", body), body, now)
+		require.ErrorIs(t, err, webhook.ErrStaleRequest)
+
+		future := now.Add(webhook.DefaultSignatureTolerance + time.Minute)
+		err = verifier.Verify(ctx, jb, signedHeader(secret, future, "nonce-5", body), body, now)
+		require.ErrorIs(t, err, webhook.ErrStaleRequest)
+	})
+
+	t.Run("rejects jobs without a signing secret", func(t *testing.T) {
+		unsigned, _ := cltest.MustInsertWebhookSpec(t, db)
+
+		err := verifier.Verify(testutils.Context(t), unsigned, signedHeader(secret, now, "nonce-6", body), body, now)
+		require.ErrorIs(t, err, webhook.ErrRequestSigningDisabled)
+	})
+}
+
+func Test_ValidateRequestBody(t *testing.T) {
+	t.Parallel()
+
+	spec := job.WebhookSpec{RequestSchema: null.StringFrom(`{"type": "object", "required": ["price"], "properties": {"price": {"type": "number"}}}`)}
+
+	assert.NoError(t, webhook.ValidateRequestBody(spec, []byte(`{"price": 42}`)))
+	assert.ErrorContains(t, webhook.ValidateRequestBody(spec, []byte(`{"price": "42"}`)), "request body does not match requestSchema")
+	assert.ErrorContains(t, webhook.ValidateRequestBody(spec, []byte(`{}`)), "request body does not match requestSchema")
+	assert.ErrorContains(t, webhook.ValidateRequestBody(spec, []byte(`not json`)), "request body is not valid JSON")
+	assert.NoError(t, webhook.ValidateRequestBody(job.WebhookSpec{}, []byte(`not json`)))
+
+	withRef := job.WebhookSpec{RequestSchema: null.StringFrom(`{"$ref": "file:///etc/passwd"}`)}
+	assert.ErrorContains(t, webhook.ValidateRequestBody(withRef, []byte(`{}`)), "loading external schema file:///etc/passwd is not allowed")
+}
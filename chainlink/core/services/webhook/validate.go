@@ -3,9 +3,11 @@ package webhook
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -18,6 +20,9 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	SigningSecret      string                             `toml:"signingSecret"`
+	SignatureTolerance time.Duration                      `toml:"signatureTolerance"`
+	RequestSchema      string                             `toml:"requestSchema"`
 }
 
 func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -55,12 +60,31 @@ func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitia
 		externalInitiatorWebhookSpecs = append(externalInitiatorWebhookSpecs, eiWS)
 	}
 
+	if tomlSpec.SigningSecret == "" {
+		if tomlSpec.SignatureTolerance != 0 || tomlSpec.RequestSchema != "" {
+			err = stderrors.Join(err, errors.New("signatureTolerance and requestSchema require a signingSecret"))
+		}
+	} else if len(tomlSpec.SigningSecret) < minSigningSecretLength {
+		err = stderrors.Join(err, errors.Errorf("signingSecret must be at least %d characters long", minSigningSecretLength))
+	}
+	if tomlSpec.SignatureTolerance < 0 {
+		err = stderrors.Join(err, errors.Errorf("signatureTolerance must not be negative, got %s", tomlSpec.SignatureTolerance))
+	}
+	if tomlSpec.RequestSchema != "" {
+		if _, schemaErr := compileRequestSchema(tomlSpec.RequestSchema); schemaErr != nil {
+			err = stderrors.Join(err, errors.Wrap(schemaErr, "invalid requestSchema"))
+		}
+	}
+
 	if err != nil {
 		return jb, err
 	}
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		SigningSecret:                 null.NewString(tomlSpec.SigningSecret, tomlSpec.SigningSecret != ""),
+		SignatureTolerance:            tomlSpec.SignatureTolerance,
+		RequestSchema:                 null.NewString(tomlSpec.RequestSchema, tomlSpec.RequestSchema != ""),
 	}
 
 	return jb, nil
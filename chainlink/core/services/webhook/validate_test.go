@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
@@ -144,6 +145,60 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded\nunable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with request signing",
+			toml: `
+			type               = "webhook"
+			schemaVersion      = 1
+			signingSecret      = "0123456789abcdef"
+			signatureTolerance = "1m"
+			requestSchema      = '{"type": "object", "required": ["price"]}'
+			observationSource  = """
+				ds_parse [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, "0123456789abcdef", s.WebhookSpec.SigningSecret.String)
+				assert.Equal(t, time.Minute, s.WebhookSpec.SignatureTolerance)
+				assert.JSONEq(t, `{"type": "object", "required": ["price"]}`, s.WebhookSpec.RequestSchema.String)
+			},
+		},
+		{
+			name: "with invalid request signing",
+			toml: `
+			type               = "webhook"
+			schemaVersion      = 1
+			signingSecret      = "short"
+			signatureTolerance = "-1m"
+			requestSchema      = '{"type": "nope"}'
+			observationSource  = """
+				ds_parse [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "signingSecret must be at least 16 characters long")
+				assert.Contains(t, err.Error(), "signatureTolerance must not be negative")
+				assert.Contains(t, err.Error(), "invalid requestSchema")
+			},
+		},
+		{
+			name: "request schema without signing secret",
+			toml: `
+			type               = "webhook"
+			schemaVersion      = 1
+			requestSchema      = '{"type": "object"}'
+			observationSource  = """
+				ds_parse [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "signatureTolerance and requestSchema require a signingSecret")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
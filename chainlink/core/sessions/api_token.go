@@ -0,0 +1,132 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// DefaultAPITokenExpiry is the lifetime of named API tokens created without an explicit expiry.
+	DefaultAPITokenExpiry = 30 * 24 * time.Hour
+	// MaxAPITokenNameLength is the maximum length of the name of an API token.
+	MaxAPITokenNameLength = 64
+	// APITokenUsageInterval is how often the use of a named API token is recorded, so that authenticating a request
+	// does not write to the database every time.
+	APITokenUsageInterval = time.Minute
+)
+
+// APIToken is a named API token of a local user. Unlike the token stored on the user itself, a user can have
+// several of them, each of which expires and can further restrict the permissions of the user.
+type APIToken struct {
+	ID                int64
+	Email             string
+	Name              string
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	// Role downgrades the user to a less privileged built-in role when set.
+	Role null.String
+	// Scope restricts the token to the given permissions when not empty, e.g. "jobs:view" for a read-only jobs API
+	// token.
+	Scope      Permissions
+	ExpiresAt  time.Time
+	LastUsedAt null.Time
+	CreatedAt  time.Time
+}
+
+// CreateAPITokenRequest is sent when creating a named API token.
+type CreateAPITokenRequest struct {
+	Password string `json:"password"`
+	Name     string `json:"name"`
+	// ExpiresIn is a duration, e.g. "720h", defaulting to DefaultAPITokenExpiry.
+	ExpiresIn string   `json:"expiresIn"`
+	Role      string   `json:"role"`
+	Scope     []string `json:"scope"`
+}
+
+// NewAPIToken validates the request and returns a new API token for the user with the given email, along with its
+// secret which is only available at creation time.
+func NewAPIToken(email string, request CreateAPITokenRequest) (APIToken, *auth.Token, error) {
+	if request.Name == "" || len(request.Name) > MaxAPITokenNameLength {
+		return APIToken{}, nil, pkgerrors.Errorf("token name must be between 1 and %d characters", MaxAPITokenNameLength)
+	}
+	expiresIn := DefaultAPITokenExpiry
+	if request.ExpiresIn != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(request.ExpiresIn)
+		if err != nil {
+			return APIToken{}, nil, pkgerrors.Wrap(err, "invalid expiresIn")
+		}
+		if expiresIn <= 0 {
+			return APIToken{}, nil, pkgerrors.New("expiresIn must be positive")
+		}
+	}
+	apiToken := APIToken{
+		Email:     email,
+		Name:      request.Name,
+		ExpiresAt: time.Now().Add(expiresIn),
+	}
+	if request.Role != "" {
+		role, err := GetUserRole(request.Role)
+		if err != nil {
+			return APIToken{}, nil, err
+		}
+		apiToken.Role = null.StringFrom(string(role))
+	}
+	scope, err := ParsePermissions(request.Scope)
+	if err != nil {
+		return APIToken{}, nil, err
+	}
+	apiToken.Scope = scope
+
+	token := auth.NewToken()
+	apiToken.TokenKey = token.AccessKey
+	apiToken.TokenSalt = utils.NewSecret(utils.DefaultSecretSize)
+	apiToken.TokenHashedSecret, err = auth.HashedSecret(token, apiToken.TokenSalt)
+	if err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "api token")
+	}
+	return apiToken, token, nil
+}
+
+// Expired returns true if the token can no longer be used.
+func (t APIToken) Expired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+// Restricted returns true if the role or the scope of the token restrict the permissions of the user.
+func (t APIToken) Restricted() bool {
+	return t.Role.Valid || len(t.Scope) > 0
+}
+
+// UsageOutdated returns true if the last recorded use of the token is older than APITokenUsageInterval.
+func (t APIToken) UsageOutdated() bool {
+	return !t.LastUsedAt.Valid || time.Since(t.LastUsedAt.Time) >= APITokenUsageInterval
+}
+
+// Allows returns true if neither the role nor the scope of the token restrict action on resource. The permissions
+// of the user still apply on top of it.
+func (t APIToken) Allows(resource Resource, action Action, scope string) bool {
+	if t.Role.Valid && !BuiltinPermissions(UserRole(t.Role.String)).Allows(resource, action, scope) {
+		return false
+	}
+	if len(t.Scope) > 0 && !t.Scope.Allows(resource, action, scope) {
+		return false
+	}
+	return true
+}
+
+// Authenticate returns true if token matches the hashed secret of the API token.
+func (t APIToken) Authenticate(token *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
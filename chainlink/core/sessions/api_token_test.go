@@ -0,0 +1,98 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		request   sessions.CreateAPITokenRequest
+		wantError bool
+	}{
+		{"defaults", sessions.CreateAPITokenRequest{Name: "ci"}, false},
+		{"restricted", sessions.CreateAPITokenRequest{Name: "ci", ExpiresIn: "1h", Role: "view", Scope: []string{"jobs:view"}}, false},
+		{"no name", sessions.CreateAPITokenRequest{}, true},
+		{"long name", sessions.CreateAPITokenRequest{Name: string(make([]byte, sessions.MaxAPITokenNameLength+1))}, true},
+		{"bad expiry", sessions.CreateAPITokenRequest{Name: "ci", ExpiresIn: "tomorrow"}, true},
+		{"negative expiry", sessions.CreateAPITokenRequest{Name: "ci", ExpiresIn: "-1h"}, true},
+		{"bad role", sessions.CreateAPITokenRequest{Name: "ci", Role: "root"}, true},
+		{"bad scope", sessions.CreateAPITokenRequest{Name: "ci", Scope: []string{"jobs"}}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiToken, token, err := sessions.NewAPIToken("user@chainlink.test", test.request)
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.request.Name, apiToken.Name)
+			assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+			assert.False(t, apiToken.Expired())
+			assert.True(t, apiToken.ExpiresAt.Before(time.Now().Add(sessions.DefaultAPITokenExpiry+time.Minute)))
+
+			ok, err := apiToken.Authenticate(token)
+			require.NoError(t, err)
+			assert.True(t, ok)
+			ok, err = apiToken.Authenticate(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+			require.NoError(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestAPIToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sessions.APIToken{ExpiresAt: time.Now().Add(-time.Second)}.Expired())
+	assert.False(t, sessions.APIToken{ExpiresAt: time.Now().Add(time.Minute)}.Expired())
+}
+
+func TestUser_Can_APIToken(t *testing.T) {
+	t.Parallel()
+
+	apiToken, _, err := sessions.NewAPIToken("user@chainlink.test", sessions.CreateAPITokenRequest{Name: "read-only", Role: "view"})
+	require.NoError(t, err)
+	user := sessions.User{Role: sessions.UserRoleAdmin, APIToken: &apiToken}
+	assert.True(t, user.Can(sessions.ResourceKeys, sessions.ActionView, ""))
+	assert.False(t, user.Can(sessions.ResourceKeys, sessions.ActionEdit, ""))
+
+	apiToken, _, err = sessions.NewAPIToken("user@chainlink.test", sessions.CreateAPITokenRequest{Name: "jobs", Scope: []string{"jobs:run"}})
+	require.NoError(t, err)
+	user.APIToken = &apiToken
+	assert.True(t, user.Can(sessions.ResourceJobs, sessions.ActionRun, ""))
+	assert.False(t, user.Can(sessions.ResourceJobs, sessions.ActionEdit, ""))
+	assert.False(t, user.Can(sessions.ResourceBridges, sessions.ActionView, ""))
+
+	// the token can't grant more than the role of the user
+	user.Role = sessions.UserRoleView
+	assert.False(t, user.Can(sessions.ResourceJobs, sessions.ActionRun, ""))
+}
+
+func TestAPIToken_Restricted(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, sessions.APIToken{}.Restricted())
+	assert.True(t, sessions.APIToken{Role: null.StringFrom("view")}.Restricted())
+	assert.True(t, sessions.APIToken{Scope: sessions.Permissions{{Resource: sessions.ResourceJobs, Action: sessions.ActionView}}}.Restricted())
+}
+
+func TestAPIToken_UsageOutdated(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sessions.APIToken{}.UsageOutdated())
+	assert.False(t, sessions.APIToken{LastUsedAt: null.TimeFrom(time.Now())}.UsageOutdated())
+	assert.True(t, sessions.APIToken{LastUsedAt: null.TimeFrom(time.Now().Add(-sessions.APITokenUsageInterval))}.UsageOutdated())
+}
@@ -26,6 +26,9 @@ var ErrUserSessionExpired = errors.New("session missing or expired, please login
 // ErrNotSupported defines the error where interface functionality doesn't align with the underlying Auth Provider
 var ErrNotSupported = fmt.Errorf("functionality not supported with current authentication provider: %w", errors.ErrUnsupported)
 
+// ErrRoleInUse is returned when deleting a custom role which is assigned to users
+var ErrRoleInUse = errors.New("role is assigned to users")
+
 // ErrEmptySessionID captures the empty case error message
 var ErrEmptySessionID = errors.New("session ID cannot be empty")
 
@@ -40,6 +43,28 @@ type BasicAdminUsersORM interface {
 	FindUser(ctx context.Context, email string) (User, error)
 }
 
+// RolesORM manages the custom roles which can be assigned to local users. Roles are assigned through
+// AuthenticationProvider.UpdateRole, like the built-in roles.
+type RolesORM interface {
+	ListRoles(ctx context.Context) ([]Role, error)
+	FindRole(ctx context.Context, name string) (Role, error)
+	CreateRole(ctx context.Context, role *Role) error
+	UpdateRolePermissions(ctx context.Context, name string, permissions Permissions) (Role, error)
+	// DeleteRole fails with ErrRoleInUse if the role is still assigned to a user.
+	DeleteRole(ctx context.Context, name string) error
+}
+
+// APITokensORM manages the named API tokens of local users, which are accepted by
+// AuthenticationProvider.FindUserByAPIToken along with the token stored on the user. They are only
+// supported by the local provider: the LDAP and OIDC providers ignore them, so they cannot be created
+// with these.
+type APITokensORM interface {
+	ListAPITokens(ctx context.Context, email string) ([]APIToken, error)
+	CreateAPIToken(ctx context.Context, token *APIToken) error
+	DeleteAPIToken(ctx context.Context, email, name string) error
+	MarkAPITokenUsed(ctx context.Context, id int64) error
+}
+
 // AuthenticationProvider is an interface that abstracts the required application calls to a user management backend
 // Currently localauth (users table DB) or LDAP server (readonly)
 type AuthenticationProvider interface {
@@ -0,0 +1,58 @@
+package localauth
+
+import (
+	"context"
+	"database/sql"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+type apiTokensORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ sessions.APITokensORM = (*apiTokensORM)(nil)
+
+// NewAPITokensORM returns the ORM of the named API tokens of the local users.
+func NewAPITokensORM(ds sqlutil.DataSource) sessions.APITokensORM {
+	return &apiTokensORM{ds: ds}
+}
+
+// ListAPITokens returns the named API tokens of the user, including expired ones, ordered by name.
+func (o *apiTokensORM) ListAPITokens(ctx context.Context, email string) (tokens []sessions.APIToken, err error) {
+	err = o.ds.SelectContext(ctx, &tokens, "SELECT * FROM api_tokens WHERE lower(email) = lower($1) ORDER BY name ASC", email)
+	return
+}
+
+// CreateAPIToken saves a new named API token, whose name must be unique for its user.
+func (o *apiTokensORM) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	stmt := `INSERT INTO api_tokens (email, name, token_key, token_salt, token_hashed_secret, role, scope, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now()) RETURNING *`
+	return pkgerrors.Wrap(o.ds.GetContext(ctx, token, stmt, token.Email, token.Name, token.TokenKey, token.TokenSalt,
+		token.TokenHashedSecret, token.Role, token.Scope, token.ExpiresAt), "CreateAPIToken failed")
+}
+
+// DeleteAPIToken revokes a named API token of the user.
+func (o *apiTokensORM) DeleteAPIToken(ctx context.Context, email, name string) error {
+	result, err := o.ds.ExecContext(ctx, "DELETE FROM api_tokens WHERE lower(email) = lower($1) AND name = $2", email, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// MarkAPITokenUsed records that a named API token was just used to authenticate a request.
+func (o *apiTokensORM) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, "UPDATE api_tokens SET last_used_at = now() WHERE id = $1", id)
+	return err
+}
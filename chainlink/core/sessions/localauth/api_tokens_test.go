@@ -0,0 +1,79 @@
+package localauth_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+)
+
+func TestAPITokensORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, orm := setupORM(t)
+	tokensORM := localauth.NewAPITokensORM(db)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &user))
+
+	apiToken, token, err := sessions.NewAPIToken(user.Email, sessions.CreateAPITokenRequest{Name: "ci", Role: "view", Scope: []string{"jobs:view"}})
+	require.NoError(t, err)
+	require.NoError(t, tokensORM.CreateAPIToken(ctx, &apiToken))
+	assert.NotZero(t, apiToken.ID)
+
+	expired, expiredToken, err := sessions.NewAPIToken(user.Email, sessions.CreateAPITokenRequest{Name: "expired", ExpiresIn: "1h"})
+	require.NoError(t, err)
+	require.NoError(t, tokensORM.CreateAPIToken(ctx, &expired))
+	_, err = db.Exec("UPDATE api_tokens SET expires_at = now() - interval '1 minute' WHERE id = $1", expired.ID)
+	require.NoError(t, err)
+
+	t.Run("find user", func(t *testing.T) {
+		found, err := orm.FindUserByAPIToken(ctx, token.AccessKey)
+		require.NoError(t, err)
+		assert.Equal(t, user.Email, found.Email)
+		require.NotNil(t, found.APIToken)
+		assert.Equal(t, "ci", found.APIToken.Name)
+		assert.Equal(t, []string{"jobs:view"}, found.APIToken.Scope.Strings())
+
+		ok, err := sessions.AuthenticateUserByToken(token, &found)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, found.Can(sessions.ResourceJobs, sessions.ActionView, ""))
+		assert.False(t, found.Can(sessions.ResourceJobs, sessions.ActionRun, ""))
+
+		_, err = orm.FindUserByAPIToken(ctx, expiredToken.AccessKey)
+		require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+
+		_, err = orm.FindUserByAPIToken(ctx, "missing")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("mark used", func(t *testing.T) {
+		require.NoError(t, tokensORM.MarkAPITokenUsed(ctx, apiToken.ID))
+
+		tokens, err := tokensORM.ListAPITokens(ctx, user.Email)
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+		assert.Equal(t, "ci", tokens[0].Name)
+		assert.True(t, tokens[0].LastUsedAt.Valid)
+		assert.False(t, tokens[1].LastUsedAt.Valid)
+	})
+
+	require.NoError(t, tokensORM.DeleteAPIToken(ctx, user.Email, "ci"))
+	require.ErrorIs(t, tokensORM.DeleteAPIToken(ctx, user.Email, "ci"), sql.ErrNoRows)
+	_, err = orm.FindUserByAPIToken(ctx, token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// tokens are deleted along with their user
+	require.NoError(t, orm.DeleteUser(ctx, user.Email))
+	tokens, err := tokensORM.ListAPITokens(ctx, user.Email)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -3,12 +3,14 @@ package localauth
 import (
 	"context"
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
@@ -45,15 +47,37 @@ func (o *orm) FindUser(ctx context.Context, email string) (sessions.User, error)
 	return o.findUser(ctx, email)
 }
 
-// FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
+// FindUserByAPIToken will attempt to return an API user via the user's table token_key column, or else via one of
+// its named API tokens, which must not have expired.
 func (o *orm) FindUserByAPIToken(ctx context.Context, apiToken string) (user sessions.User, err error) {
-	sql := "SELECT * FROM users WHERE token_key = $1"
-	err = o.ds.GetContext(ctx, &user, sql, apiToken)
+	stmt := "SELECT users.*, roles.permissions FROM users LEFT JOIN roles ON roles.name = users.custom_role WHERE token_key = $1"
+	err = o.ds.GetContext(ctx, &user, stmt, apiToken)
+	if !pkgerrors.Is(err, sql.ErrNoRows) {
+		return
+	}
+
+	var token sessions.APIToken
+	if err = o.ds.GetContext(ctx, &token, "SELECT * FROM api_tokens WHERE token_key = $1", apiToken); err != nil {
+		return
+	}
+	if token.Expired() {
+		return user, sessions.ErrUserSessionExpired
+	}
+	if user, err = o.findUser(ctx, token.Email); err != nil {
+		return
+	}
+	user.APIToken = &token
 	return
 }
 
+// MarkAPITokenUsed records that a named API token was just used to authenticate a request.
+func (o *orm) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	return NewAPITokensORM(o.ds).MarkAPITokenUsed(ctx, id)
+}
+
+// findUser also loads the permissions of the custom role of the user, if any.
 func (o *orm) findUser(ctx context.Context, email string) (user sessions.User, err error) {
-	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	sql := "SELECT users.*, roles.permissions FROM users LEFT JOIN roles ON roles.name = users.custom_role WHERE lower(email) = lower($1)"
 	err = o.ds.GetContext(ctx, &user, sql, email)
 	return
 }
@@ -266,10 +290,19 @@ func (o *orm) UpdateRole(ctx context.Context, email, newRole string) (sessions.U
 			return pkgerrors.New("no matching user for provided email")
 		}
 
-		// Patch validated role
+		// Patch validated role, a custom role replaces the permissions of the built-in role which is reset to the
+		// least privileged one
+		customRole := null.String{}
 		userRole, err := sessions.GetUserRole(newRole)
 		if err != nil {
-			return err
+			var exists bool
+			if err2 := tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM roles WHERE name = $1)", newRole); err2 != nil {
+				return pkgerrors.Wrap(err2, "error finding custom role")
+			}
+			if !exists {
+				return pkgerrors.Wrap(err, "no custom role with this name either")
+			}
+			userRole, customRole = sessions.UserRoleView, null.StringFrom(newRole)
 		}
 		userToEdit.Role = userRole
 
@@ -279,8 +312,8 @@ func (o *orm) UpdateRole(ctx context.Context, email, newRole string) (sessions.U
 			return pkgerrors.New("error updating API user")
 		}
 
-		sql := "UPDATE users SET role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
-		if err := tx.GetContext(ctx, &userToEdit, sql, userToEdit.Role, email); err != nil {
+		sql := "UPDATE users SET role = $1, custom_role = $2, updated_at = now() WHERE lower(email) = lower($3) RETURNING *"
+		if err := tx.GetContext(ctx, &userToEdit, sql, userToEdit.Role, customRole, email); err != nil {
 			o.lggr.Errorw("Error updating API user", "err", err)
 			return pkgerrors.New("error updating API user")
 		}
@@ -0,0 +1,80 @@
+package localauth
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgconn"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+type rolesORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ sessions.RolesORM = (*rolesORM)(nil)
+
+// NewRolesORM returns the ORM of the custom roles, which are stored along with the local users.
+func NewRolesORM(ds sqlutil.DataSource) sessions.RolesORM {
+	return &rolesORM{ds: ds}
+}
+
+// ListRoles returns all the custom roles ordered by name.
+func (o *rolesORM) ListRoles(ctx context.Context) (roles []sessions.Role, err error) {
+	err = o.ds.SelectContext(ctx, &roles, "SELECT * FROM roles ORDER BY name ASC")
+	return
+}
+
+// FindRole returns the custom role with the given name.
+func (o *rolesORM) FindRole(ctx context.Context, name string) (role sessions.Role, err error) {
+	err = o.ds.GetContext(ctx, &role, "SELECT * FROM roles WHERE name = $1", name)
+	return
+}
+
+// CreateRole saves a new custom role.
+func (o *rolesORM) CreateRole(ctx context.Context, role *sessions.Role) error {
+	if err := sessions.ValidateRoleName(role.Name); err != nil {
+		return err
+	}
+	stmt := "INSERT INTO roles (name, permissions, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
+	return pkgerrors.Wrap(o.ds.GetContext(ctx, role, stmt, role.Name, role.Permissions), "CreateRole failed")
+}
+
+// UpdateRolePermissions replaces the permissions of a custom role, they apply to the next request of its users.
+func (o *rolesORM) UpdateRolePermissions(ctx context.Context, name string, permissions sessions.Permissions) (role sessions.Role, err error) {
+	stmt := "UPDATE roles SET permissions = $1, updated_at = now() WHERE name = $2 RETURNING *"
+	err = o.ds.GetContext(ctx, &role, stmt, permissions, name)
+	return
+}
+
+// DeleteRole deletes a custom role which is not assigned to any user.
+func (o *rolesORM) DeleteRole(ctx context.Context, name string) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		var inUse bool
+		if err := tx.GetContext(ctx, &inUse, "SELECT EXISTS (SELECT 1 FROM users WHERE custom_role = $1)", name); err != nil {
+			return pkgerrors.Wrap(err, "error checking role usage")
+		}
+		if inUse {
+			return sessions.ErrRoleInUse
+		}
+		result, err := tx.ExecContext(ctx, "DELETE FROM roles WHERE name = $1", name)
+		if err != nil {
+			var pqErr *pgconn.PgError
+			if pkgerrors.As(err, &pqErr) && pqErr.Code == "23503" {
+				return sessions.ErrRoleInUse
+			}
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
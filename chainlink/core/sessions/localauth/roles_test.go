@@ -0,0 +1,78 @@
+package localauth_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+)
+
+func TestRolesORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db, orm := setupORM(t)
+	rolesORM := localauth.NewRolesORM(db)
+
+	permissions, err := sessions.ParsePermissions([]string{"bridges:edit", "jobs:run:abc"})
+	require.NoError(t, err)
+	role := sessions.Role{Name: "bridge-manager", Permissions: permissions}
+	require.NoError(t, rolesORM.CreateRole(ctx, &role))
+	assert.False(t, role.CreatedAt.IsZero())
+
+	require.Error(t, rolesORM.CreateRole(ctx, &sessions.Role{Name: "admin"}))
+
+	found, err := rolesORM.FindRole(ctx, role.Name)
+	require.NoError(t, err)
+	assert.Equal(t, permissions, found.Permissions)
+
+	_, err = rolesORM.FindRole(ctx, "missing")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	t.Run("assign to user", func(t *testing.T) {
+		u := cltest.MustRandomUser(t)
+		require.NoError(t, orm.CreateUser(ctx, &u))
+
+		updated, err := orm.UpdateRole(ctx, u.Email, role.Name)
+		require.NoError(t, err)
+		assert.Equal(t, role.Name, updated.CustomRole.String)
+
+		u, err = orm.FindUser(ctx, u.Email)
+		require.NoError(t, err)
+		assert.Equal(t, role.Name, u.RoleName())
+		assert.True(t, u.Can(sessions.ResourceBridges, sessions.ActionEdit, ""))
+		assert.False(t, u.Can(sessions.ResourceKeys, sessions.ActionView, ""))
+
+		_, err = rolesORM.UpdateRolePermissions(ctx, role.Name, sessions.Permissions{{Resource: sessions.ResourceKeys, Action: sessions.ActionView}})
+		require.NoError(t, err)
+		u, err = orm.FindUser(ctx, u.Email)
+		require.NoError(t, err)
+		assert.False(t, u.Can(sessions.ResourceBridges, sessions.ActionEdit, ""))
+		assert.True(t, u.Can(sessions.ResourceKeys, sessions.ActionView, ""))
+
+		require.ErrorIs(t, rolesORM.DeleteRole(ctx, role.Name), sessions.ErrRoleInUse)
+
+		updated, err = orm.UpdateRole(ctx, u.Email, string(sessions.UserRoleRun))
+		require.NoError(t, err)
+		assert.False(t, updated.CustomRole.Valid)
+		assert.Equal(t, sessions.UserRoleRun, updated.Role)
+	})
+
+	u := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &u))
+	_, err = orm.UpdateRole(ctx, u.Email, "missing")
+	require.Error(t, err)
+
+	roles, err := rolesORM.ListRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+
+	require.NoError(t, rolesORM.DeleteRole(ctx, role.Name))
+	require.ErrorIs(t, rolesORM.DeleteRole(ctx, role.Name), sql.ErrNoRows)
+}
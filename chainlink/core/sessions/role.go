@@ -0,0 +1,228 @@
+package sessions
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Resource is a group of API endpoints which permissions are granted on.
+type Resource string
+
+const (
+	// ResourceAll matches every resource.
+	ResourceAll                Resource = "*"
+	ResourceUsers              Resource = "users"
+	ResourceBridges            Resource = "bridges"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceJobs               Resource = "jobs"
+	ResourceKeys               Resource = "keys"
+	ResourceFeeds              Resource = "feeds"
+	ResourceTransfers          Resource = "transfers"
+	ResourceChains             Resource = "chains"
+	ResourceTransactions       Resource = "transactions"
+	ResourceConfig             Resource = "config"
+	ResourceAudit              Resource = "audit"
+	// ResourceNode covers the endpoints which do not belong to any other resource.
+	ResourceNode Resource = "node"
+)
+
+var resources = []Resource{
+	ResourceAll,
+	ResourceUsers,
+	ResourceBridges,
+	ResourceExternalInitiators,
+	ResourceJobs,
+	ResourceKeys,
+	ResourceFeeds,
+	ResourceTransfers,
+	ResourceChains,
+	ResourceTransactions,
+	ResourceConfig,
+	ResourceAudit,
+	ResourceNode,
+}
+
+// Action is the level of access granted on a resource. Like the built-in roles, each action includes the ones
+// below it: admin > edit > run > view.
+type Action string
+
+const (
+	ActionView  Action = "view"
+	ActionRun   Action = "run"
+	ActionEdit  Action = "edit"
+	ActionAdmin Action = "admin"
+)
+
+var actionLevels = map[Action]int{
+	ActionView:  1,
+	ActionRun:   2,
+	ActionEdit:  3,
+	ActionAdmin: 4,
+}
+
+// Includes returns true if the action a grants the action other.
+func (a Action) Includes(other Action) bool {
+	return actionLevels[a] >= actionLevels[other]
+}
+
+// Permission grants an action on a resource, optionally restricted to a single instance of the resource, for
+// example a bridge name or an external job ID. Its string form is "resource:action[:scope]".
+type Permission struct {
+	Resource Resource
+	Action   Action
+	Scope    string
+}
+
+// ParsePermission parses a permission from its "resource:action[:scope]" string form.
+func ParsePermission(s string) (Permission, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: must be of the form resource:action[:scope]", s)
+	}
+	p := Permission{Resource: Resource(parts[0]), Action: Action(parts[1])}
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return Permission{}, pkgerrors.Errorf("invalid permission %q: scope must not be empty", s)
+		}
+		p.Scope = parts[2]
+	}
+	if !isResource(p.Resource) {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: unknown resource %q, allowed resources: %s", s, p.Resource, resourceNames())
+	}
+	if _, ok := actionLevels[p.Action]; !ok {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: unknown action %q, allowed actions: '%s', '%s', '%s', '%s'", s, p.Action, ActionView, ActionRun, ActionEdit, ActionAdmin)
+	}
+	if p.Resource == ResourceAll && p.Scope != "" {
+		return Permission{}, pkgerrors.Errorf("invalid permission %q: permissions on all resources cannot be scoped", s)
+	}
+	return p, nil
+}
+
+func (p Permission) String() string {
+	s := fmt.Sprintf("%s:%s", p.Resource, p.Action)
+	if p.Scope != "" {
+		s += ":" + p.Scope
+	}
+	return s
+}
+
+// Allows returns true if the permission grants action on resource. An empty scope requires an unscoped permission.
+func (p Permission) Allows(resource Resource, action Action, scope string) bool {
+	if p.Resource != ResourceAll && p.Resource != resource {
+		return false
+	}
+	if p.Scope != "" && p.Scope != scope {
+		return false
+	}
+	return p.Action.Includes(action)
+}
+
+func isResource(r Resource) bool {
+	for _, resource := range resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceNames() string {
+	names := make([]string, len(resources))
+	for i, r := range resources {
+		names[i] = fmt.Sprintf("'%s'", r)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Permissions is a set of permissions, stored as an array of their string form.
+type Permissions []Permission
+
+// ParsePermissions parses each of the permissions from their string form.
+func ParsePermissions(strs []string) (Permissions, error) {
+	ps := make(Permissions, len(strs))
+	for i, s := range strs {
+		p, err := ParsePermission(s)
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	return ps, nil
+}
+
+// Allows returns true if any of the permissions grants action on resource.
+func (ps Permissions) Allows(resource Resource, action Action, scope string) bool {
+	for _, p := range ps {
+		if p.Allows(resource, action, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// Strings returns the string form of the permissions.
+func (ps Permissions) Strings() []string {
+	strs := make([]string, len(ps))
+	for i, p := range ps {
+		strs[i] = p.String()
+	}
+	return strs
+}
+
+// Value returns this instance serialized for database storage.
+func (ps Permissions) Value() (driver.Value, error) {
+	return pq.StringArray(ps.Strings()).Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (ps *Permissions) Scan(value any) error {
+	var strs pq.StringArray
+	if err := strs.Scan(value); err != nil {
+		return err
+	}
+	if len(strs) == 0 {
+		*ps = nil
+		return nil
+	}
+	parsed, err := ParsePermissions(strs)
+	if err != nil {
+		return err
+	}
+	*ps = parsed
+	return nil
+}
+
+// BuiltinPermissions returns the permissions of a built-in role, which grants its action on every resource.
+func BuiltinPermissions(role UserRole) Permissions {
+	if _, ok := actionLevels[Action(role)]; !ok {
+		return nil
+	}
+	return Permissions{{Resource: ResourceAll, Action: Action(role)}}
+}
+
+var roleNameRegex = regexp.MustCompile("^[a-zA-Z0-9-_]+$")
+
+// Role is a custom role which can be assigned to local users in place of the built-in roles.
+type Role struct {
+	Name        string
+	Permissions Permissions
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ValidateRoleName returns an error if name cannot be used for a custom role.
+func ValidateRoleName(name string) error {
+	if !roleNameRegex.MatchString(name) {
+		return pkgerrors.Errorf("invalid role name %q: must only contain letters, digits, '-' and '_'", name)
+	}
+	if _, err := GetUserRole(name); err == nil {
+		return pkgerrors.Errorf("invalid role name %q: conflicts with a built-in role", name)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		want      sessions.Permission
+		wantError bool
+	}{
+		{"bridges:edit", sessions.Permission{Resource: sessions.ResourceBridges, Action: sessions.ActionEdit}, false},
+		{"*:view", sessions.Permission{Resource: sessions.ResourceAll, Action: sessions.ActionView}, false},
+		{"jobs:run:a:b", sessions.Permission{Resource: sessions.ResourceJobs, Action: sessions.ActionRun, Scope: "a:b"}, false},
+		{"bridges", sessions.Permission{}, true},
+		{"bridges:", sessions.Permission{}, true},
+		{"bridges:delete", sessions.Permission{}, true},
+		{"widgets:view", sessions.Permission{}, true},
+		{"jobs:run:", sessions.Permission{}, true},
+		{"*:run:abc", sessions.Permission{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := sessions.ParsePermission(test.input)
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, p)
+			assert.Equal(t, test.input, p.String())
+		})
+	}
+}
+
+func TestPermissions_Allows(t *testing.T) {
+	t.Parallel()
+
+	ps, err := sessions.ParsePermissions([]string{"bridges:edit", "jobs:run:abc"})
+	require.NoError(t, err)
+
+	assert.True(t, ps.Allows(sessions.ResourceBridges, sessions.ActionView, ""))
+	assert.True(t, ps.Allows(sessions.ResourceBridges, sessions.ActionEdit, "any-bridge"))
+	assert.False(t, ps.Allows(sessions.ResourceBridges, sessions.ActionAdmin, ""))
+	assert.True(t, ps.Allows(sessions.ResourceJobs, sessions.ActionRun, "abc"))
+	assert.True(t, ps.Allows(sessions.ResourceJobs, sessions.ActionView, "abc"))
+	assert.False(t, ps.Allows(sessions.ResourceJobs, sessions.ActionRun, "def"))
+	assert.False(t, ps.Allows(sessions.ResourceJobs, sessions.ActionView, ""))
+	assert.False(t, ps.Allows(sessions.ResourceKeys, sessions.ActionView, ""))
+}
+
+func TestUser_Can(t *testing.T) {
+	t.Parallel()
+
+	run := sessions.User{Role: sessions.UserRoleRun}
+	assert.True(t, run.Can(sessions.ResourceKeys, sessions.ActionView, ""))
+	assert.True(t, run.Can(sessions.ResourceJobs, sessions.ActionRun, "abc"))
+	assert.False(t, run.Can(sessions.ResourceBridges, sessions.ActionEdit, ""))
+	assert.Equal(t, "run", run.RoleName())
+
+	ps, err := sessions.ParsePermissions([]string{"bridges:edit"})
+	require.NoError(t, err)
+	custom := sessions.User{Role: sessions.UserRoleView, CustomRole: null.StringFrom("bridge-manager"), Permissions: ps}
+	assert.True(t, custom.Can(sessions.ResourceBridges, sessions.ActionEdit, ""))
+	assert.False(t, custom.Can(sessions.ResourceKeys, sessions.ActionView, ""))
+	assert.Equal(t, "bridge-manager", custom.RoleName())
+}
+
+func TestValidateRoleName(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, sessions.ValidateRoleName("bridge-manager_2"))
+	assert.Error(t, sessions.ValidateRoleName(""))
+	assert.Error(t, sessions.ValidateRoleName("has space"))
+	assert.Error(t, sessions.ValidateRoleName("admin"))
+	assert.Error(t, sessions.ValidateRoleName("view"))
+}
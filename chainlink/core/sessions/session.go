@@ -64,8 +64,12 @@ func (u *User) SetAuthToken(token *auth.Token) error {
 }
 
 // AuthenticateUserByToken returns true on successful authentication of the
-// user against the given Authentication Token.
+// user against the given Authentication Token, or against its named API token
+// if the user was found by one.
 func AuthenticateUserByToken(token *auth.Token, user *User) (bool, error) {
+	if user.APIToken != nil {
+		return user.APIToken.Authenticate(token)
+	}
 	hashedSecret, err := auth.HashedSecret(token, user.TokenSalt.ValueOrZero())
 	if err != nil {
 		return false, err
@@ -21,6 +21,12 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// CustomRole is the name of the custom role of the user, which replaces the permissions of Role when set.
+	CustomRole null.String
+	// Permissions are the permissions of CustomRole, loaded along with the user.
+	Permissions Permissions
+	// APIToken is the named API token the user authenticated with, if any, which can further restrict its permissions.
+	APIToken *APIToken `db:"-"`
 }
 
 type UserRole string
@@ -32,6 +38,26 @@ const (
 	UserRoleView  UserRole = "view"
 )
 
+// Can returns true if the user is permitted action on resource. A non-empty scope, for example a bridge name or an
+// external job ID, also accepts permissions restricted to that instance of the resource.
+func (u User) Can(resource Resource, action Action, scope string) bool {
+	if u.APIToken != nil && !u.APIToken.Allows(resource, action, scope) {
+		return false
+	}
+	if u.CustomRole.Valid {
+		return u.Permissions.Allows(resource, action, scope)
+	}
+	return BuiltinPermissions(u.Role).Allows(resource, action, scope)
+}
+
+// RoleName returns the name of the custom role of the user if set, its built-in role otherwise.
+func (u User) RoleName() string {
+	if u.CustomRole.Valid {
+		return u.CustomRole.String
+	}
+	return string(u.Role)
+}
+
 // https://security.stackexchange.com/questions/39849/does-bcrypt-have-a-maximum-password-length
 const (
 	MaxBcryptPasswordLength = 50
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'active',
+    ADD CONSTRAINT chk_state CHECK (state IN ('active', 'paused'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+    DROP CONSTRAINT chk_state,
+    DROP COLUMN state;
+-- +goose StatementEnd
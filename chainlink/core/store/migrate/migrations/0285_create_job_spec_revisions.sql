@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- job_spec_revisions intentionally has no foreign key to jobs: updating a job
+-- deletes and re-inserts it under the same id, and its history must survive that.
+CREATE TABLE job_spec_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL,
+    revision INT NOT NULL,
+    toml TEXT NOT NULL,
+    author TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT job_spec_revisions_job_id_revision_key UNIQUE (job_id, revision)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job_spec_revisions;
+-- +goose StatementEnd
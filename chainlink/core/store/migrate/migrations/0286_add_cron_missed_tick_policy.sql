@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    ADD COLUMN missed_tick_policy TEXT NOT NULL DEFAULT 'skip',
+    ADD COLUMN max_catch_up_runs BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN last_fired_at TIMESTAMPTZ,
+    ADD CONSTRAINT chk_missed_tick_policy CHECK (missed_tick_policy IN ('skip', 'runOnce', 'runAll'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    DROP CONSTRAINT chk_missed_tick_policy,
+    DROP COLUMN missed_tick_policy,
+    DROP COLUMN max_catch_up_runs,
+    DROP COLUMN jitter,
+    DROP COLUMN last_fired_at;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE webhook_specs
+    ADD COLUMN signing_secret TEXT,
+    ADD COLUMN signature_tolerance BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN request_schema TEXT;
+
+-- Nonces are keyed by the external job id rather than the webhook spec, so that they survive job updates.
+CREATE TABLE webhook_request_nonces (
+    external_job_id UUID NOT NULL,
+    nonce TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (external_job_id, nonce)
+);
+CREATE INDEX idx_webhook_request_nonces_created_at ON webhook_request_nonces (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_request_nonces;
+ALTER TABLE webhook_specs
+    DROP COLUMN signing_secret,
+    DROP COLUMN signature_tolerance,
+    DROP COLUMN request_schema;
+-- +goose StatementEnd
@@ -0,0 +1,86 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE log_trigger_specs(
+    id BIGSERIAL PRIMARY KEY,
+
+    contract_address BYTEA NOT NULL CHECK (octet_length(contract_address) = 20),
+    event_abi TEXT NOT NULL,
+    topic1 TEXT[] NOT NULL DEFAULT '{}',
+    topic2 TEXT[] NOT NULL DEFAULT '{}',
+    topic3 TEXT[] NOT NULL DEFAULT '{}',
+    min_confirmations BIGINT NOT NULL DEFAULT 0,
+    from_block BIGINT NOT NULL DEFAULT 0,
+    poll_period BIGINT NOT NULL DEFAULT 0,
+    evm_chain_id NUMERIC(78) NOT NULL,
+
+    cursor_block BIGINT,
+    cursor_log_index BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+ALTER TABLE jobs
+    ADD COLUMN log_trigger_spec_id INT REFERENCES log_trigger_specs (id),
+DROP CONSTRAINT chk_specs,
+    ADD CONSTRAINT chk_specs CHECK (
+      num_nonnulls(
+        ocr_oracle_spec_id, ocr2_oracle_spec_id,
+        direct_request_spec_id, flux_monitor_spec_id,
+        keeper_spec_id, cron_spec_id, webhook_spec_id,
+        vrf_spec_id, blockhash_store_spec_id,
+        block_header_feeder_spec_id, bootstrap_spec_id,
+        gateway_spec_id,
+        legacy_gas_station_server_spec_id,
+        legacy_gas_station_sidecar_spec_id,
+        eal_spec_id,
+        workflow_spec_id,
+        standard_capabilities_spec_id,
+        ccip_spec_id,
+        ccip_bootstrap_spec_id,
+        cre_settings_spec_id,
+        log_trigger_spec_id,
+        CASE "type"
+	  WHEN 'stream'
+	  THEN 1
+	  ELSE NULL
+        END -- 'stream' type lacks a spec but should not cause validation to fail
+      ) = 1
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+DROP CONSTRAINT chk_specs,
+     ADD CONSTRAINT chk_specs CHECK (
+      num_nonnulls(
+        ocr_oracle_spec_id, ocr2_oracle_spec_id,
+        direct_request_spec_id, flux_monitor_spec_id,
+        keeper_spec_id, cron_spec_id, webhook_spec_id,
+        vrf_spec_id, blockhash_store_spec_id,
+        block_header_feeder_spec_id, bootstrap_spec_id,
+        gateway_spec_id,
+        legacy_gas_station_server_spec_id,
+        legacy_gas_station_sidecar_spec_id,
+        eal_spec_id,
+        workflow_spec_id,
+        standard_capabilities_spec_id,
+        ccip_spec_id,
+        ccip_bootstrap_spec_id,
+        cre_settings_spec_id,
+        CASE "type"
+	  WHEN 'stream'
+	  THEN 1
+	  ELSE NULL
+        END -- 'stream' type lacks a spec but should not cause validation to fail
+      ) = 1
+    );
+
+ALTER TABLE jobs
+DROP COLUMN log_trigger_spec_id;
+
+DROP TABLE log_trigger_specs;
+
+-- +goose StatementEnd
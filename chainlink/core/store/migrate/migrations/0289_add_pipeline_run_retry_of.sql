@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- No foreign key, so that pruning runs does not have to look up their retries.
+ALTER TABLE pipeline_runs ADD COLUMN retry_of_run_id BIGINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pipeline_runs DROP COLUMN retry_of_run_id;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN fallback_urls TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN health_check_path TEXT NOT NULL DEFAULT '',
+    ADD COLUMN health_check_interval BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN fallback_urls,
+    DROP COLUMN health_check_path,
+    DROP COLUMN health_check_interval;
+-- +goose StatementEnd
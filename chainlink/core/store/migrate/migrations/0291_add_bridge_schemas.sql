@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN request_schema TEXT NOT NULL DEFAULT '',
+    ADD COLUMN response_schema TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN request_schema,
+    DROP COLUMN response_schema;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles (
+    name TEXT PRIMARY KEY CHECK (name <> '' AND name NOT IN ('admin', 'edit', 'run', 'view')),
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- a role cannot be deleted while it is assigned, otherwise its users would silently fall back to their built-in role
+ALTER TABLE users ADD COLUMN custom_role TEXT REFERENCES roles (name) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN custom_role;
+DROP TABLE roles;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    email TEXT NOT NULL REFERENCES users (email) ON DELETE CASCADE ON UPDATE CASCADE,
+    name TEXT NOT NULL CHECK (name <> ''),
+    token_key TEXT NOT NULL UNIQUE,
+    token_salt TEXT NOT NULL,
+    token_hashed_secret TEXT NOT NULL,
+    role user_roles,
+    scope TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (email, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE api_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- data is stored as TEXT rather than JSONB so that it is kept byte for byte as it was hashed
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id TEXT NOT NULL,
+    user_email TEXT NOT NULL DEFAULT '',
+    data TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_audit_events_event_id ON audit_events (event_id);
+CREATE INDEX idx_audit_events_user_email ON audit_events (user_email);
+CREATE INDEX idx_audit_events_created_at ON audit_events (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
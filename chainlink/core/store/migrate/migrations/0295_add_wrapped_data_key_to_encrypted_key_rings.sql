@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- when set, the key ring is encrypted with a data key wrapped by an external key encryption key provider instead of
+-- the keystore password
+ALTER TABLE encrypted_key_rings
+    ADD COLUMN wrapped_data_key BYTEA,
+    ADD COLUMN kek_provider TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE encrypted_key_rings
+    DROP COLUMN wrapped_data_key,
+    DROP COLUMN kek_provider;
+-- +goose StatementEnd
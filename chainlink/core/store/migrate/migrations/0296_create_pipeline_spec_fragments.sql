@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pipeline_spec_fragments (
+    pipeline_spec_id INT NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+    fragment_name TEXT NOT NULL,
+    PRIMARY KEY (pipeline_spec_id, fragment_name)
+);
+CREATE INDEX idx_pipeline_spec_fragments_fragment_name ON pipeline_spec_fragments (fragment_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE pipeline_spec_fragments;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE http_response_cache (
+    key TEXT PRIMARY KEY,
+    value BYTEA NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_http_response_cache_fetched_at ON http_response_cache (fetched_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE http_response_cache;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- audit events are queued here as they are audited, and moved to the hash chain of audit_events in the background
+CREATE TABLE audit_event_queue (
+    id BIGSERIAL PRIMARY KEY,
+    event_id TEXT NOT NULL,
+    user_email TEXT NOT NULL DEFAULT '',
+    data TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_event_queue;
+-- +goose StatementEnd
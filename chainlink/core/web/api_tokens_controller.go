@@ -0,0 +1,144 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// errAPITokensUnsupported is returned when creating a named API token while users authenticate with LDAP or OIDC,
+// whose providers only accept their own API tokens.
+var errAPITokensUnsupported = errors.New("named API tokens are only supported with the local WebServer.AuthenticationMethod")
+
+// APITokensController manages the named API tokens of the current user.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// authenticatedUser returns the current user. Requests authenticated with a restricted API token are rejected, so
+// that such a token can neither revoke the other tokens of the user nor create less restricted ones.
+func (tc *APITokensController) authenticatedUser(c *gin.Context) (*clsession.User, bool) {
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return nil, false
+	}
+	if user.APIToken != nil && user.APIToken.Restricted() {
+		jsonAPIError(c, http.StatusForbidden, errors.New("API tokens cannot be managed with a restricted API token"))
+		return nil, false
+	}
+	return user, true
+}
+
+// Index lists the named API tokens of the current user.
+func (tc *APITokensController) Index(c *gin.Context) {
+	user, ok := tc.authenticatedUser(c)
+	if !ok {
+		return
+	}
+	tokens, err := tc.App.APITokensORM().ListAPITokens(c.Request.Context(), user.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// Create generates a new named API token for the current user. Its secret is only returned once.
+func (tc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	if clsession.AuthenticationProviderName(tc.App.GetConfig().WebServer().AuthenticationMethod()) != clsession.LocalAuth {
+		jsonAPIError(c, http.StatusBadRequest, errAPITokensUnsupported)
+		return
+	}
+	var request clsession.CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := tc.authenticatedUser(c)
+	if !ok {
+		return
+	}
+	// Named API tokens are stored along with the local users
+	if _, err := tc.App.BasicAdminUsersORM().FindUser(ctx, sessionUser.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to create API token"))
+		return
+	}
+	// In order to create an API token, login validation with provided password must succeed
+	if err := tc.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password); err != nil {
+		tc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]any{"user": sessionUser.Email, "name": request.Name})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	apiToken, token, err := clsession.NewAPIToken(sessionUser.Email, request)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	orm := tc.App.APITokensORM()
+	tokens, err := orm.ListAPITokens(ctx, sessionUser.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, t := range tokens {
+		if t.Name == apiToken.Name {
+			jsonAPIError(c, http.StatusConflict, fmt.Errorf("API token %s already exists", apiToken.Name))
+			return
+		}
+	}
+	if err = orm.CreateAPIToken(ctx, &apiToken); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]any{
+		"user":      sessionUser.Email,
+		"name":      apiToken.Name,
+		"role":      apiToken.Role.String,
+		"scope":     apiToken.Scope.Strings(),
+		"expiresAt": apiToken.ExpiresAt,
+	})
+
+	resource := presenters.NewAPITokenResource(apiToken)
+	resource.AccessKey = token.AccessKey
+	resource.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, resource, "api_token", http.StatusCreated)
+}
+
+// Destroy revokes a named API token of the current user.
+func (tc *APITokensController) Destroy(c *gin.Context) {
+	user, ok := tc.authenticatedUser(c)
+	if !ok {
+		return
+	}
+	name := c.Param("name")
+	if err := tc.App.APITokensORM().DeleteAPIToken(c.Request.Context(), user.Email, name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]any{"user": user.Email, "name": name})
+	jsonAPIResponseWithStatus(c, nil, "api_token", http.StatusNoContent)
+}
@@ -0,0 +1,120 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	appmocks "github.com/smartcontractkit/chainlink/v2/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAPITokensController(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	create := func(t *testing.T, request sessions.CreateAPITokenRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	resp := create(t, sessions.CreateAPITokenRequest{Password: "wrong-password", Name: "read-only-jobs"})
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = create(t, sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "read-only-jobs", Scope: []string{"jobs:bad"}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = create(t, sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "read-only-jobs", ExpiresIn: "1h", Scope: []string{"jobs:view"}})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var created presenters.APITokenResource
+	cltest.ParseJSONAPIResponse(t, resp, &created)
+	assert.Equal(t, "read-only-jobs", created.Name)
+	assert.Equal(t, []string{"jobs:view"}, created.Scope)
+	require.NotEmpty(t, created.AccessKey)
+	require.NotEmpty(t, created.Secret)
+
+	resp = create(t, sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "read-only-jobs"})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	do := func(t *testing.T, method, path string) int {
+		req, err := http.NewRequestWithContext(ctx, method, app.Server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, created.AccessKey)
+		req.Header.Set(webauth.APISecret, created.Secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, "/v2/jobs"))
+	assert.Equal(t, http.StatusForbidden, do(t, http.MethodGet, "/v2/bridge_types"))
+	// a restricted token cannot manage the API tokens of the user
+	assert.Equal(t, http.StatusForbidden, do(t, http.MethodGet, "/v2/user/tokens"))
+	assert.Equal(t, http.StatusForbidden, do(t, http.MethodDelete, "/v2/user/tokens/read-only-jobs"))
+
+	listResp, cleanup := client.Get("/v2/user/tokens")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	var tokens []presenters.APITokenResource
+	cltest.ParseJSONAPIResponse(t, listResp, &tokens)
+	require.Len(t, tokens, 1)
+	assert.Empty(t, tokens[0].Secret)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+
+	deleteResp, cleanup := client.Delete("/v2/user/tokens/read-only-jobs")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNoContent, deleteResp.StatusCode)
+
+	assert.Equal(t, http.StatusUnauthorized, do(t, http.MethodGet, "/v2/jobs"))
+
+	deleteResp, cleanup = client.Delete("/v2/user/tokens/read-only-jobs")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, deleteResp.StatusCode)
+}
+
+func TestAPITokensController_Create_UnsupportedAuthenticationMethod(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		method := string(sessions.LDAPAuth)
+		c.WebServer.AuthenticationMethod = &method
+	})
+	mockApp := appmocks.NewApplication(t)
+	mockApp.EXPECT().GetConfig().Return(cfg)
+	controller := web.APITokensController{App: mockApp}
+
+	body, err := json.Marshal(sessions.CreateAPITokenRequest{Password: cltest.Password, Name: "read-only-jobs"})
+	require.NoError(t, err)
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, err = http.NewRequestWithContext(t.Context(), "POST", "/v2/user/tokens", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	controller.Create(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "only supported with the local WebServer.AuthenticationMethod")
+}
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+var errAuditLocalSinkDisabled = errors.New("the audit logger local sink is not enabled, see AuditLogger.LocalSinkEnabled")
+
+// AuditController queries the audit events persisted by the local sink of the audit logger.
+type AuditController struct {
+	App chainlink.Application
+}
+
+// Index lists the persisted audit events, most recent first.
+// Example:
+// "GET <application>/audit?eventID=API_TOKEN_CREATED&user=a@b.c&from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z"
+func (ac *AuditController) Index(c *gin.Context, size, page, offset int) {
+	if !ac.localSinkEnabled() {
+		jsonAPIError(c, http.StatusBadRequest, errAuditLocalSinkDisabled)
+		return
+	}
+
+	filter := audit.EventsFilter{
+		EventID:   audit.EventID(c.Query("eventID")),
+		UserEmail: c.Query("user"),
+		Limit:     size,
+		Offset:    offset,
+	}
+	var err error
+	if filter.From, err = parseAuditTime(c.Query("from")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid from: %w", err))
+		return
+	}
+	if filter.To, err = parseAuditTime(c.Query("to")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid to: %w", err))
+		return
+	}
+
+	events, count, err := audit.NewORM(ac.App.GetDB(), ac.App.GetConfig().AuditLogger().LocalSinkHashKey()).FindEvents(c.Request.Context(), filter)
+	paginatedResponse(c, "AuditEvents", size, page, presenters.NewAuditEventResources(events), count, err)
+}
+
+// Verify checks the integrity of the hash chain of the persisted audit events.
+// Example:
+// "GET <application>/audit/verify"
+func (ac *AuditController) Verify(c *gin.Context) {
+	if !ac.localSinkEnabled() {
+		jsonAPIError(c, http.StatusBadRequest, errAuditLocalSinkDisabled)
+		return
+	}
+
+	result, err := audit.NewORM(ac.App.GetDB(), ac.App.GetConfig().AuditLogger().LocalSinkHashKey()).Verify(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAuditVerifyResource(result), "audit_verification")
+}
+
+func (ac *AuditController) localSinkEnabled() bool {
+	cfg := ac.App.GetConfig().AuditLogger()
+	return cfg.Enabled() && cfg.LocalSinkEnabled()
+}
+
+func parseAuditTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
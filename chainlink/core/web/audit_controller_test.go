@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAuditController(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AuditLogger.Enabled = ptr(true)
+		c.AuditLogger.LocalSinkEnabled = ptr(true)
+		s.AuditLogger.LocalSinkHashKey = models.NewSecret("audit-hash-key")
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	orm := audit.NewORM(app.GetDB(), "audit-hash-key")
+	require.NoError(t, orm.Enqueue(ctx, audit.AuthLoginSuccessNo2FA, audit.Data{"email": "a@chainlink.test"}))
+	require.NoError(t, orm.Enqueue(ctx, audit.APITokenCreated, audit.Data{"user": "a@chainlink.test", "name": "ci"}))
+	_, err := orm.Chain(ctx)
+	require.NoError(t, err)
+	created, _, err := orm.FindEvents(ctx, audit.EventsFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+
+	resp, cleanup := client.Get("/v2/audit?eventID=API_TOKEN_CREATED&user=a@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var events []presenters.AuditEventResource
+	cltest.ParseJSONAPIResponse(t, resp, &events)
+	require.Len(t, events, 1)
+	assert.Equal(t, audit.APITokenCreated, events[0].EventID)
+	assert.Equal(t, created[0].Hash, events[0].Hash)
+	assert.JSONEq(t, `{"user": "a@chainlink.test", "name": "ci"}`, string(events[0].Data))
+
+	resp, cleanup = client.Get("/v2/audit?from=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/audit/verify")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var result presenters.AuditVerifyResource
+	cltest.ParseJSONAPIResponse(t, resp, &result)
+	assert.True(t, result.Valid)
+	assert.Equal(t, created[0].Hash, result.LastHash)
+}
+
+func TestAuditController_LocalSinkDisabled(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get("/v2/audit/verify")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -44,6 +44,12 @@ type Authenticator interface {
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
 }
 
+// apiTokenUsageRecorder is implemented by the authenticators which support named API tokens, to record when they
+// were last used.
+type apiTokenUsageRecorder interface {
+	MarkAPITokenUsed(ctx context.Context, id int64) error
+}
+
 // authMethod defines a method which can be used to authenticate a request. This
 // can be implemented according to your authentication method (i.e by session,
 // token, etc)
@@ -106,6 +112,14 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	if user.APIToken != nil && user.APIToken.UsageOutdated() {
+		if recorder, ok := authr.(apiTokenUsageRecorder); ok {
+			if err := recorder.MarkAPITokenUsed(ctx, user.APIToken.ID); err != nil {
+				return errors.Wrap(err, "recording API token usage")
+			}
+		}
+	}
+
 	c.Set(SessionUserKey, &user)
 
 	return nil
@@ -197,59 +211,32 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
-// 'run'
+// RequiresRunRole extracts the user object from the context, and asserts the user is permitted at least 'run' on
+// the resource of the route
 func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
+		if authorize(c, clsessions.ActionRun) {
+			handler(c)
 		}
-		handler(c)
 	}
 }
 
-// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
-// 'edit'
+// RequiresEditRole extracts the user object from the context, and asserts the user is permitted at least 'edit' on
+// the resource of the route
 func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
+		if authorize(c, clsessions.ActionEdit) {
+			handler(c)
 		}
-		handler(c)
 	}
 }
 
-// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
+// RequiresAdminRole extracts the user object from the context, and asserts the user is permitted 'admin' on the
+// resource of the route
 func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
-		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
-			return
-		}
-		if user.Role != clsessions.UserRoleAdmin {
-			c.Abort()
-			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
-			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
-			return
+		if authorize(c, clsessions.ActionAdmin) {
+			handler(c)
 		}
-		handler(c)
 	}
 }
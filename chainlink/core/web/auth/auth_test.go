@@ -86,6 +86,53 @@ func TestAuthenticateByToken_Success(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
 }
 
+type apiTokenUsageRecorder struct {
+	userFindSuccesser
+	used []int64
+}
+
+func (r *apiTokenUsageRecorder) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	r.used = append(r.used, id)
+	return nil
+}
+
+func TestAuthenticateByToken_NamedAPIToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	apiToken, token, err := sessions.NewAPIToken(user.Email, sessions.CreateAPITokenRequest{Name: "ci"})
+	require.NoError(t, err)
+	apiToken.ID = 42
+	user.APIToken = &apiToken
+	authr := &apiTokenUsageRecorder{userFindSuccesser: userFindSuccesser{user: user}}
+
+	called := false
+	router := gin.New()
+	router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+	router.GET("/", func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req := mustRequest(t, "GET", "/", nil)
+	req.Header.Set(webauth.APIKey, token.AccessKey)
+	req.Header.Set(webauth.APISecret, "bad-secret")
+	router.ServeHTTP(w, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
+	assert.Empty(t, authr.used)
+
+	w = httptest.NewRecorder()
+	req = mustRequest(t, "GET", "/", nil)
+	req.Header.Set(webauth.APIKey, token.AccessKey)
+	req.Header.Set(webauth.APISecret, token.Secret)
+	router.ServeHTTP(w, req)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+	assert.Equal(t, []int64{42}, authr.used)
+}
+
 func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	authr := userFindFailer{err: auth.ErrorAuthFailed}
 
@@ -222,6 +269,13 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"GET", "/v2/roles", false, false, false},
+	{"POST", "/v2/roles", false, false, false},
+	{"GET", "/v2/roles/MOCK", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
+	{"GET", "/v2/audit", false, false, false},
+	{"GET", "/v2/audit/verify", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
@@ -307,6 +361,7 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/vrf/MOCK", false, false, false},
 	{"POST", "/v2/keys/vrf/import", false, false, false},
 	{"POST", "/v2/keys/vrf/export/MOCK", false, false, false},
+	{"POST", "/v2/keystore/rotate-password", false, false, false},
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
@@ -521,6 +576,63 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 	}
 }
 
+func TestRBAC_CustomRole(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	router := web.Router(t, app, nil)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	externalJobID := jb.ExternalJobID
+	permissions, err := sessions.ParsePermissions([]string{"bridges:edit", "jobs:run:" + externalJobID.String()})
+	require.NoError(t, err)
+	role := sessions.Role{Name: "bridge-manager", Permissions: permissions}
+	require.NoError(t, app.RolesORM().CreateRole(ctx, &role))
+
+	client := app.NewHTTPClient(&cltest.User{CustomRole: role.Name})
+
+	for _, tc := range []struct {
+		verb, path string
+		status     int
+	}{
+		{"GET", "/v2/bridge_types", 0},
+		{"POST", "/v2/bridge_types", 0},
+		{"DELETE", "/v2/bridge_types/MOCK", 0},
+		{"POST", "/v2/jobs/" + externalJobID.String() + "/runs", 0},
+		{"POST", "/v2/jobs/" + uuid.New().String() + "/runs", http.StatusUnauthorized},
+		{"GET", fmt.Sprintf("/v2/jobs/%d", jb.ID), 0},
+		{"GET", fmt.Sprintf("/v2/jobs/%d", jb.ID+1), http.StatusForbidden},
+		{"GET", "/v2/jobs", http.StatusForbidden},
+		{"GET", "/v2/keys/eth", http.StatusForbidden},
+		{"GET", "/v2/users", http.StatusForbidden},
+		{"GET", "/v2/ping", 0},
+	} {
+		t.Run(tc.verb+" "+tc.path, func(t *testing.T) {
+			var resp *http.Response
+			var cleanup func()
+			switch tc.verb {
+			case "GET":
+				resp, cleanup = client.Get(tc.path)
+			case "POST":
+				resp, cleanup = client.Post(tc.path, nil)
+			case "DELETE":
+				resp, cleanup = client.Delete(tc.path)
+			}
+			defer cleanup()
+
+			if tc.status == 0 {
+				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+			} else {
+				assert.Equal(t, tc.status, resp.StatusCode)
+			}
+		})
+	}
+}
+
 func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request {
 	ctx := testutils.Context(t)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// routeResource maps the routes starting with prefix to the resource they belong to. The scope of a request is
+// the value of its scopeParam path parameter, if any, resolved by the ScopeResolver of the resource.
+type routeResource struct {
+	prefix     string
+	resource   clsessions.Resource
+	scopeParam string
+}
+
+// selfServiceRoutes are the routes every authenticated user needs to manage their own account, regardless of their
+// role. They are matched exactly, so that new routes are never open to every role by accident.
+var selfServiceRoutes = map[string]struct{}{
+	"/v2/user/password":     {},
+	"/v2/user/token":        {},
+	"/v2/user/token/delete": {},
+	"/v2/user/tokens":       {},
+	"/v2/user/tokens/:name": {},
+	"/v2/enroll_webauthn":   {},
+	"/v2/ping":              {},
+}
+
+// routeResources is matched in order, the first matching prefix wins. Routes which do not match any prefix belong
+// to clsessions.ResourceNode, so that new routes are not accessible to restricted custom roles by default.
+var routeResources = []routeResource{
+	{prefix: "/v2/users", resource: clsessions.ResourceUsers},
+	{prefix: "/v2/roles", resource: clsessions.ResourceUsers},
+	{prefix: "/v2/external_initiators", resource: clsessions.ResourceExternalInitiators, scopeParam: "Name"},
+	{prefix: "/v2/bridge_types", resource: clsessions.ResourceBridges, scopeParam: "BridgeName"},
+	{prefix: "/v2/pipeline_fragments", resource: clsessions.ResourceJobs},
+	{prefix: "/v2/jobs", resource: clsessions.ResourceJobs, scopeParam: "ID"},
+	{prefix: "/v2/pipeline", resource: clsessions.ResourceJobs},
+	{prefix: "/v2/execute_capability", resource: clsessions.ResourceJobs},
+	{prefix: "/v2/keystore", resource: clsessions.ResourceKeys},
+	{prefix: "/v2/keys", resource: clsessions.ResourceKeys},
+	{prefix: "/v2/transfers", resource: clsessions.ResourceTransfers},
+	{prefix: "/v2/tx_attempts", resource: clsessions.ResourceTransactions},
+	{prefix: "/v2/transactions", resource: clsessions.ResourceTransactions},
+	{prefix: "/v2/chains", resource: clsessions.ResourceChains},
+	{prefix: "/v2/nodes", resource: clsessions.ResourceChains},
+	{prefix: "/v2/replay_from_block", resource: clsessions.ResourceChains},
+	{prefix: "/v2/find_lca", resource: clsessions.ResourceChains},
+	{prefix: "/v2/config", resource: clsessions.ResourceConfig},
+	{prefix: "/v2/log", resource: clsessions.ResourceConfig},
+	{prefix: "/v2/features", resource: clsessions.ResourceConfig},
+	{prefix: "/v2/build_info", resource: clsessions.ResourceConfig},
+	{prefix: "/v2/audit", resource: clsessions.ResourceAudit},
+}
+
+// ScopeResolver resolves the scope path parameter of a request to the scope permissions of its resource refer to,
+// e.g. a job ID to its external job ID. An empty scope means that the parameter does not refer to anything.
+type ScopeResolver func(ctx context.Context, param string) (string, error)
+
+const scopeResolversKey = "scope_resolvers"
+
+// ResolveScopes is middleware which registers the scope resolvers of the resources, so that the scopes of
+// requests are the ones permissions of the resources refer to. It must be used before the permission middleware.
+func ResolveScopes(resolvers map[clsessions.Resource]ScopeResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(scopeResolversKey, resolvers)
+		c.Next()
+	}
+}
+
+// RouteResource returns the resource the route of the request belongs to and the scope of the request. An empty
+// resource means that the route is accessible to every authenticated user.
+func RouteResource(c *gin.Context) (clsessions.Resource, string, error) {
+	rr, ok := findRouteResource(c.FullPath())
+	if !ok {
+		return "", "", nil
+	}
+	scope, err := rr.scope(c)
+	return rr.resource, scope, err
+}
+
+func findRouteResource(route string) (routeResource, bool) {
+	if _, ok := selfServiceRoutes[route]; ok {
+		return routeResource{}, false
+	}
+	for _, rr := range routeResources {
+		if strings.HasPrefix(route, rr.prefix) {
+			return rr, true
+		}
+	}
+	return routeResource{resource: clsessions.ResourceNode}, true
+}
+
+// scope returns the scope of the request, resolved by the ScopeResolver registered for the resource, if any.
+func (rr routeResource) scope(c *gin.Context) (string, error) {
+	if rr.scopeParam == "" {
+		return "", nil
+	}
+	param := c.Param(rr.scopeParam)
+	if param == "" {
+		return "", nil
+	}
+	if resolvers, ok := c.Value(scopeResolversKey).(map[clsessions.Resource]ScopeResolver); ok {
+		if resolve, ok := resolvers[rr.resource]; ok {
+			scope, err := resolve(c.Request.Context(), param)
+			return scope, errors.Wrapf(err, "failed to resolve the %s scope %q", rr.resource, param)
+		}
+	}
+	return param, nil
+}
+
+// RequiresViewPermission is middleware which asserts the authenticated user can at least view the resource the
+// route belongs to. It must be used after Authenticate.
+func RequiresViewPermission(c *gin.Context) {
+	if authorize(c, clsessions.ActionView) {
+		c.Next()
+	}
+}
+
+// authorize asserts the authenticated user is permitted action on the resource of the route, and aborts the
+// request otherwise.
+func authorize(c *gin.Context, action clsessions.Action) bool {
+	user, ok := GetAuthenticatedUser(c)
+	if !ok {
+		c.Abort()
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+		return false
+	}
+	rr, ok := findRouteResource(c.FullPath())
+	if !ok || user.Can(rr.resource, action, "") {
+		return true
+	}
+	// Only resolve the scope for users whose permissions are scoped
+	scope, err := rr.scope(c)
+	if err != nil {
+		c.Abort()
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	if scope != "" && user.Can(rr.resource, action, scope) {
+		return true
+	}
+	c.Abort()
+	switch action {
+	case clsessions.ActionRun, clsessions.ActionEdit:
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+	default:
+		addForbiddenErrorHeaders(c, string(action), user.RoleName(), user.Email)
+		jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+	}
+	return false
+}
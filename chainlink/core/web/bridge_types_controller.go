@@ -46,6 +46,30 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 	if len(strings.TrimSpace(u)) == 0 {
 		fe.Add("URL must be present")
 	}
+	for _, fallbackURL := range bt.FallbackURLs {
+		if len(strings.TrimSpace(fallbackURL.String())) == 0 {
+			fe.Add("FallbackURLs must not be empty")
+			break
+		}
+	}
+	if !bt.HealthCheckInterval.IsZero() {
+		if bt.HealthCheckPath == "" {
+			fe.Add("HealthCheckInterval requires a HealthCheckPath")
+		}
+		if bt.HealthCheckInterval.Duration() < bridges.MinHealthCheckInterval {
+			fe.Add(fmt.Sprintf("HealthCheckInterval must be at least %s", bridges.MinHealthCheckInterval))
+		}
+	}
+	if bt.RequestSchema != "" {
+		if err := bridges.ValidateSchema(bt.RequestSchema); err != nil {
+			fe.Add(fmt.Sprintf("RequestSchema is not a valid JSON Schema: %v", err))
+		}
+	}
+	if bt.ResponseSchema != "" {
+		if err := bridges.ValidateSchema(bt.ResponseSchema); err != nil {
+			fe.Add(fmt.Sprintf("ResponseSchema is not a valid JSON Schema: %v", err))
+		}
+	}
 	if bt.MinimumContractPayment != nil &&
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
@@ -96,7 +120,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusConflict, apiErr)
 		return
 	}
-	resource := presenters.NewBridgeResource(*bt)
+	resource := presenters.NewBridgeResource(*bt, btc.App.BridgeHealthChecker().Health(*bt))
 	resource.IncomingToken = bta.IncomingToken
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]any{
@@ -104,6 +128,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeFallbackURLs":           bta.FallbackURLs,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -114,9 +139,10 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 	ctx := c.Request.Context()
 	bridges, count, err := btc.App.BridgeORM().BridgeTypes(ctx, offset, size)
 
+	health := btc.App.BridgeHealthChecker()
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *presenters.NewBridgeResource(bridge, health.Health(bridge)))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +169,7 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.BridgeHealthChecker().Health(bt)), "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -187,9 +213,10 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeFallbackURLs":           bt.FallbackURLs,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.BridgeHealthChecker().Health(bt)), "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -229,5 +256,5 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]any{"name": name})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.BridgeHealthChecker().Health(bt)), "bridge")
 }
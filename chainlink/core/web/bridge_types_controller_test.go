@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -85,6 +87,64 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid fallback urls and health check",
+			bridges.BridgeTypeRequest{
+				Name:                "adapterwithfallbacks",
+				URL:                 cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				FallbackURLs:        bridges.WebURLs{cltest.WebURL(t, "http://chainlink_cmc-adapter_2:8080")},
+				HealthCheckPath:     "/health",
+				HealthCheckInterval: *sqlutil.NewInterval(10 * time.Second),
+			},
+			nil,
+		},
+		{
+			"invalid with blank fallback url",
+			bridges.BridgeTypeRequest{
+				Name:         "adapterwithfallbacks",
+				URL:          cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				FallbackURLs: bridges.WebURLs{cltest.WebURL(t, "")},
+			},
+			models.NewJSONAPIErrorsWith("FallbackURLs must not be empty"),
+		},
+		{
+			"invalid health check interval",
+			bridges.BridgeTypeRequest{
+				Name:                "adapterwithhealthcheck",
+				URL:                 cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckPath:     "/health",
+				HealthCheckInterval: *sqlutil.NewInterval(time.Second),
+			},
+			models.NewJSONAPIErrorsWith("HealthCheckInterval must be at least 5s"),
+		},
+		{
+			"invalid health check interval without path",
+			bridges.BridgeTypeRequest{
+				Name:                "adapterwithhealthcheck",
+				URL:                 cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckInterval: *sqlutil.NewInterval(10 * time.Second),
+			},
+			models.NewJSONAPIErrorsWith("HealthCheckInterval requires a HealthCheckPath"),
+		},
+		{
+			"valid with schemas",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithschemas",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				RequestSchema:  `{"type": "object"}`,
+				ResponseSchema: `{"type": "object", "required": ["data"]}`,
+			},
+			nil,
+		},
+		{
+			"invalid response schema",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithschemas",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				ResponseSchema: `{"type": 1}`,
+			},
+			models.NewJSONAPIErrorsWith("ResponseSchema is not a valid JSON Schema: " + bridges.ValidateSchema(`{"type": 1}`).Error()),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -256,15 +316,26 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	ctx := testutils.Context(t)
 	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
 
-	body := fmt.Sprintf(`{"name": "%s","url":"http://yourbridge"}`, bridgeName)
+	body := fmt.Sprintf(`{"name": "%s","url":"http://yourbridge","fallbackURLs":["http://yourfallback"],"healthCheckPath":"/health","healthCheckInterval":"10s"}`, bridgeName)
 	ud := bytes.NewBufferString(body)
 	resp, cleanup := client.Patch("/v2/bridge_types/"+bridgeName, ud)
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusOK)
 
+	var resource presenters.BridgeResource
+	cltest.ParseJSONAPIResponse(t, resp, &resource)
+	require.Len(t, resource.Endpoints, 2)
+	assert.Equal(t, "http://yourbridge", resource.Endpoints[0].URL)
+	assert.Equal(t, "http://yourfallback", resource.Endpoints[1].URL)
+	// not checked yet
+	assert.Equal(t, bridges.EndpointStatusUnknown, resource.Endpoints[1].Status)
+
 	ubt, err := app.BridgeORM().FindBridge(ctx, bt.Name)
 	assert.NoError(t, err)
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
+	assert.Equal(t, bridges.WebURLs{cltest.WebURL(t, "http://yourfallback")}, ubt.FallbackURLs)
+	assert.Equal(t, "/health", ubt.HealthCheckPath)
+	assert.Equal(t, 10*time.Second, ubt.HealthCheckInterval.Duration())
 }
 
 func TestBridgeController_Show(t *testing.T) {
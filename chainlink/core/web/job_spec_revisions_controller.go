@@ -0,0 +1,72 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobSpecRevisionsController exposes the history of accepted spec revisions
+// of a job. Rolling back to a revision is done by JobsController.Rollback.
+type JobSpecRevisionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the spec revisions of a job, newest first.
+// Example:
+// "GET <application>/jobs/:ID/revisions"
+func (jsrc *JobSpecRevisionsController) Index(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	revs, err := jsrc.App.JobORM().FindSpecRevisions(c.Request.Context(), j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.JobSpecRevisionResource{}
+	for _, rev := range revs {
+		resources = append(resources, *presenters.NewJobSpecRevisionResource(rev))
+	}
+
+	jsonAPIResponse(c, resources, "jobSpecRevisions")
+}
+
+// Show returns a single spec revision of a job, including its TOML.
+// Example:
+// "GET <application>/jobs/:ID/revisions/:revision"
+func (jsrc *JobSpecRevisionsController) Show(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	revision, err := stringutils.ToInt32(c.Param("revision"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rev, err := jsrc.App.JobORM().FindSpecRevision(c.Request.Context(), j.ID, revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job spec revision not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecRevisionResource(rev), "jobSpecRevision")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
@@ -25,14 +28,17 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/logtrigger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -93,9 +99,31 @@ func (jc *JobsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
+// jobScopeResolver resolves the :ID parameter of the job routes, a job ID or an external job ID, to the external
+// job ID the scopes of the jobs permissions refer to, as for the GraphQL API.
+func jobScopeResolver(app chainlink.Application) auth.ScopeResolver {
+	return func(ctx context.Context, param string) (string, error) {
+		if externalJobID, err := uuid.Parse(param); err == nil {
+			return externalJobID.String(), nil
+		}
+		jb := job.Job{}
+		if err := jb.SetID(param); err != nil {
+			return "", nil
+		}
+		jb, err := app.JobORM().FindJobWithoutSpecErrors(ctx, jb.ID)
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			return "", nil
+		} else if err != nil {
+			return "", err
+		}
+		return jb.ExternalJobID.String(), nil
+	}
+}
+
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
-	TOML string `json:"toml"`
+	TOML    string `json:"toml"`
+	Comment string `json:"comment"`
 }
 
 // Create validates, saves and starts a new job.
@@ -126,6 +154,11 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
+	if err = jc.recordSpecRevision(c, jb, request.TOML, request.Comment); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	jbj, err := json.Marshal(jb)
 	if err == nil {
 		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
@@ -136,6 +169,83 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// Validate runs every check of Create against a TOML spec without saving the job, and reports the
+// problems found with the line of the spec they refer to.
+// Example:
+// "POST <application>/jobs/validate"
+func (jc *JobsController) Validate(c *gin.Context) {
+	request := CreateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	jobType, verrs := jc.dryRunJobSpec(ctx, request.TOML)
+	jsonAPIResponse(c, presenters.NewJobSpecValidationResource(jobType, verrs), "jobSpecValidation")
+}
+
+// dryRunJobSpec runs the validation stages of Create in order, and returns all the errors of the
+// first one that fails.
+func (jc *JobsController) dryRunJobSpec(ctx context.Context, tomlString string) (job.Type, []job.ValidationError) {
+	expanded := tomlString
+	fail := func(stage string, err error) []job.ValidationError {
+		return job.NewValidationErrors(tomlString, expanded, stage, err)
+	}
+
+	expanded, err := job.ExpandFragments(tomlString, pipeline.NewFragmentResolver(ctx, jc.App.PipelineORM()))
+	if err != nil {
+		expanded = tomlString
+		return "", fail(job.ValidationStagePipeline, err)
+	}
+	jobType, err := job.ValidateSpec(expanded)
+	if err != nil {
+		return "", fail(job.ValidationStageTOML, err)
+	}
+	jb, _, err := jc.validateExpandedJobSpec(ctx, expanded)
+	if err != nil {
+		return jobType, fail(job.ValidationStageSpec, err)
+	}
+	// creating the job asserts that its bridges exist first, so the job stage only runs once they do
+	if err = jc.findMissingBridges(ctx, jb.Pipeline); err != nil {
+		return jobType, fail(job.ValidationStageBridges, err)
+	}
+	if err = jc.App.JobORM().ValidateJob(ctx, &jb); err != nil {
+		return jobType, fail(job.ValidationStageJob, err)
+	}
+	return jobType, nil
+}
+
+// findMissingBridges returns an error for each bridge of p which does not exist, unlike
+// job.ORM.AssertBridgesExist which stops at the first.
+func (jc *JobsController) findMissingBridges(ctx context.Context, p pipeline.Pipeline) error {
+	var errs []error
+	seen := make(map[string]struct{})
+	for _, task := range p.Tasks {
+		bridgeTask, ok := task.(*pipeline.BridgeTask)
+		if !ok {
+			continue
+		}
+		if _, ok = seen[bridgeTask.Name]; ok {
+			continue
+		}
+		seen[bridgeTask.Name] = struct{}{}
+		name, err := bridges.ParseBridgeName(bridgeTask.Name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err = jc.App.BridgeORM().FindBridge(ctx, name); errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			errs = append(errs, fmt.Errorf("bridge %q of task %s does not exist", bridgeTask.Name, bridgeTask.DotID()))
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -162,9 +272,60 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops a job's services and marks it as paused, leaving its spec in place.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setState(c, job.StatePaused)
+}
+
+// Resume marks a paused job as active and starts its services again.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setState(c, job.StateActive)
+}
+
+func (jc *JobsController) setState(c *gin.Context, state job.State) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	var event audit.EventID
+	if state == job.StatePaused {
+		event = audit.JobPaused
+		err = jc.App.JobSpawner().PauseJob(ctx, j.ID)
+	} else {
+		event = audit.JobResumed
+		err = jc.App.JobSpawner().ResumeJob(ctx, j.ID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, err := jc.App.JobORM().FindJob(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(event, map[string]any{"id": j.ID})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
-	TOML string `json:"toml"`
+	TOML    string `json:"toml"`
+	Comment string `json:"comment"`
 }
 
 // Update validates a new TOML for an existing job, stops and deletes existing job, saves and starts a new job.
@@ -177,7 +338,69 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
-	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	jc.replaceJob(c, request.TOML, request.Comment)
+}
+
+// RollbackJobRequest represents a request to replace a job with one of its previous spec revisions.
+type RollbackJobRequest struct {
+	Revision int32  `json:"revision"`
+	Comment  string `json:"comment"`
+}
+
+// Rollback replaces a job with the TOML of one of its previous spec revisions, going through the same
+// stop, delete, save and start steps as Update.
+// Example:
+// "POST <application>/jobs/:ID/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	request := RollbackJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	rev, err := jc.App.JobORM().FindSpecRevision(c.Request.Context(), j.ID, request.Revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job spec revision not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	// secrets are redacted in revisions, so the job keeps its current ones
+	current, err := jc.App.JobORM().FindJob(c.Request.Context(), j.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	tomlString, err := job.RestoreSecrets(rev.TOML, current)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	comment := request.Comment
+	if comment == "" {
+		comment = fmt.Sprintf("rollback to revision %d", rev.Revision)
+	}
+	jc.replaceJob(c, tomlString, comment)
+}
+
+// replaceJob validates tomlString and replaces the job identified by the ID param with it.
+func (jc *JobsController) replaceJob(c *gin.Context, tomlString, comment string) {
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), tomlString)
 	if err != nil {
 		jsonAPIError(c, status, err)
 		return
@@ -192,20 +415,14 @@ func (jc *JobsController) Update(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
-	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
-	err = jc.App.DeleteJob(ctx, jb.ID)
-	// Error can be either come from ORM or from the activeJobs map.
+	// If the provided job id is not matching any job, replace will fail with 404 leaving state unchanged.
+	// The job keeps its state, so a paused job stays paused.
+	err = jc.App.ReplaceJob(ctx, &jb)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
 			return
 		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
-	}
-
-	err = jc.App.AddJobV2(ctx, &jb)
-	if err != nil {
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
@@ -214,10 +431,41 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	if err = jc.recordSpecRevision(c, jb, tomlString, comment); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// recordSpecRevision stores an accepted spec in the job's history, with the pipeline the job was saved
+// with, so that included fragments are kept as they were expanded. The job has already been saved at
+// this point, which the returned error says.
+func (jc *JobsController) recordSpecRevision(c *gin.Context, jb job.Job, tomlString, comment string) error {
+	rev := job.SpecRevision{JobID: jb.ID, TOML: tomlString, Comment: comment}
+	if user, ok := auth.GetAuthenticatedUser(c); ok {
+		rev.Author = user.Email
+	}
+	var err error
+	if jb.Pipeline.Source != "" {
+		rev.TOML, err = job.ReplaceObservationSource(tomlString, jb.Pipeline.Source)
+	}
+	if err == nil {
+		err = jc.App.JobORM().InsertSpecRevision(c.Request.Context(), &rev)
+	}
+	return errors.Wrapf(err, "job %d was saved, but its spec revision could not be recorded", jb.ID)
+}
+
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
+	tomlString, err = job.ExpandFragments(tomlString, pipeline.NewFragmentResolver(ctx, jc.App.PipelineORM()))
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to expand pipeline fragments")
+	}
+	return jc.validateExpandedJobSpec(ctx, tomlString)
+}
+
+// validateExpandedJobSpec is validateJobSpec for a spec whose fragments are already expanded.
+func (jc *JobsController) validateExpandedJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
@@ -264,6 +512,8 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(tomlString)
 	case job.CCIP:
 		jb, err = ccip.ValidatedCCIPSpec(tomlString)
+	case job.LogTrigger:
+		jb, err = logtrigger.ValidatedLogTriggerSpec(tomlString)
 	default:
 		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -532,6 +533,133 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Validate(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	validate := func(t *testing.T, tomlStr string) presenters.JobSpecValidationResource {
+		body, _ := json.Marshal(web.CreateJobRequest{TOML: tomlStr})
+		response, cleanup := client.Post("/v2/jobs/validate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		resource := presenters.JobSpecValidationResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		return resource
+	}
+
+	t.Run("valid spec", func(t *testing.T) {
+		resource := validate(t, testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String()))
+		assert.True(t, resource.Valid)
+		assert.Equal(t, presenters.JobSpecType(job.Webhook), resource.Type)
+		assert.Empty(t, resource.Errors)
+
+		jobs, _, err := app.JobORM().FindJobs(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("invalid TOML", func(t *testing.T) {
+		resource := validate(t, "type = \"webhook\"\nschemaVersion = = 1\n")
+		assert.False(t, resource.Valid)
+		require.Len(t, resource.Errors, 1)
+		assert.Equal(t, job.ValidationStageTOML, resource.Errors[0].Stage)
+		assert.Equal(t, 2, resource.Errors[0].Line)
+	})
+
+	t.Run("missing bridge", func(t *testing.T) {
+		tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), "missing-bridge")
+		resource := validate(t, tomlStr)
+		assert.False(t, resource.Valid)
+		require.Len(t, resource.Errors, 1)
+		verr := resource.Errors[0]
+		assert.Equal(t, job.ValidationStageBridges, verr.Stage)
+		require.NotZero(t, verr.Line)
+		assert.Contains(t, strings.Split(tomlStr, "\n")[verr.Line-1], "missing-bridge")
+	})
+
+	t.Run("missing bridges", func(t *testing.T) {
+		tomlStr := testspecs.GetWebhookSpecNoBody(uuid.New(), "missing-fetch-bridge", "missing-submit-bridge")
+		resource := validate(t, tomlStr)
+		assert.False(t, resource.Valid)
+		require.Len(t, resource.Errors, 2)
+		for i, bridge := range []string{"missing-fetch-bridge", "missing-submit-bridge"} {
+			verr := resource.Errors[i]
+			assert.Equal(t, job.ValidationStageBridges, verr.Stage)
+			require.NotZero(t, verr.Line)
+			assert.Contains(t, strings.Split(tomlStr, "\n")[verr.Line-1], bridge)
+		}
+	})
+}
+
+func TestJobsController_SpecRevisions(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	user := &cltest.User{Email: "operator@chainlink.test"}
+	client := app.NewHTTPClient(user)
+
+	initialTOML := testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String())
+	body, _ := json.Marshal(web.CreateJobRequest{TOML: initialTOML, Comment: "initial"})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	path := "/v2/jobs/" + resource.ID
+
+	updatedTOML := testspecs.GetWebhookSpecNoBody(uuid.New(), submitBridge.Name.String(), fetchBridge.Name.String())
+	body, _ = json.Marshal(web.UpdateJobRequest{TOML: updatedTOML, Comment: "swap bridges"})
+	response, cleanup = client.Put(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	response, cleanup = client.Get(path + "/revisions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var revs []presenters.JobSpecRevisionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &revs))
+	require.Len(t, revs, 2)
+	assert.Equal(t, int32(2), revs[0].Revision)
+	assert.Equal(t, "swap bridges", revs[0].Comment)
+	assert.Equal(t, user.Email, revs[0].Author)
+	assert.Equal(t, updatedTOML, revs[0].TOML)
+	assert.Equal(t, "initial", revs[1].Comment)
+
+	body, _ = json.Marshal(web.RollbackJobRequest{Revision: 1})
+	response, cleanup = client.Post(path+"/rollback", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource = presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Contains(t, resource.PipelineSpec.DotDAGSource, fetchBridge.Name.String())
+
+	response, cleanup = client.Get(path + "/revisions/3")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	rev := presenters.JobSpecRevisionResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rev))
+	assert.Equal(t, initialTOML, rev.TOML)
+	assert.Equal(t, "rollback to revision 1", rev.Comment)
+
+	response, cleanup = client.Get(path + "/revisions/4")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	body, _ = json.Marshal(web.RollbackJobRequest{Revision: 4})
+	response, cleanup = client.Post(path+"/rollback", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -638,6 +766,37 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+	id := strconv.Itoa(int(jobID))
+
+	response, cleanup := client.Post("/v2/jobs/"+id+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, job.StatePaused, resource.State)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/"+id+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.Equal(t, job.StateActive, resource.State)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post("/v2/jobs/uuidLikeString/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func TestJobsController_Update_HappyPath(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
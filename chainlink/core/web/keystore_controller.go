@@ -0,0 +1,74 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+// RotateKeystorePasswordRequest defines the request to re-encrypt the keystore with a new password.
+type RotateKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+	// ScryptN and ScryptP are the scrypt parameters to encrypt the keystore with. Zero values keep the current ones.
+	ScryptN int `json:"scryptN"`
+	ScryptP int `json:"scryptP"`
+}
+
+// KeystoreController manages the keystore as a whole, rather than the keys it holds.
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotatePassword re-encrypts the keystore with a new password, and optionally new scrypt parameters. The password in
+// the secrets must be updated before the node is restarted.
+// Example:
+// "POST <application>/keystore/rotate-password"
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var email string
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		email = user.Email
+	}
+
+	scryptParams := utils.ScryptParams{N: request.ScryptN, P: request.ScryptP}
+	// the config is only updated once the rotation is committed, and before any other rotation
+	err := kc.App.GetKeyStore().RotatePassword(c.Request.Context(), request.OldPassword, request.NewPassword, scryptParams, func() {
+		kc.App.GetConfig().SetPasswords(&request.NewPassword, nil)
+	})
+	switch {
+	case errors.Is(err, keystore.ErrInvalidPassword):
+		kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotateAttemptFailedMismatch, map[string]any{"user": email})
+		jsonAPIError(c, http.StatusConflict, errors.New("old password does not match"))
+		return
+	case errors.Is(err, keystore.ErrInvalidScryptParams):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case errors.Is(err, keystore.ErrNoPassword):
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		kc.App.GetLogger().Errorw("Failed to rotate keystore password", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("unable to rotate keystore password"))
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotated, map[string]any{"user": email, "scryptN": request.ScryptN, "scryptP": request.ScryptP})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
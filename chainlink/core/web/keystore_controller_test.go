@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	const newPassword = "16charlengthp4SsW0rD1!@#_rotated"
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+	}{
+		{
+			name:           "Old password mismatch",
+			reqBody:        `{"oldPassword": "wrong password", "newPassword": "` + newPassword + `"}`,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "Weak new password",
+			reqBody:        `{"oldPassword": "` + cltest.Password + `", "newPassword": "weak"}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Invalid scrypt parameters",
+			reqBody:        `{"oldPassword": "` + cltest.Password + `", "newPassword": "` + newPassword + `", "scryptN": 3}`,
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Success",
+			reqBody:        `{"oldPassword": "` + cltest.Password + `", "newPassword": "` + newPassword + `", "scryptN": 4}`,
+			wantStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/keystore/rotate-password", bytes.NewBufferString(tc.reqBody))
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, tc.wantStatusCode)
+		})
+	}
+
+	assert.Equal(t, newPassword, app.GetConfig().Password().Keystore())
+	// keys can still be created, and are saved with the new password
+	_, err := app.GetKeyStore().CSA().Create(ctx)
+	require.NoError(t, err)
+	require.NoError(t, app.GetKeyStore().RotatePassword(ctx, newPassword, cltest.Password, utils.FastScryptParams, nil))
+}
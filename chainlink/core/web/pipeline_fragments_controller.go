@@ -0,0 +1,122 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineFragmentRequest is the request body for creating a pipeline fragment.
+type PipelineFragmentRequest struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// PipelineFragmentsController manages the reusable pipeline fragments which
+// job specs can include.
+type PipelineFragmentsController struct {
+	App chainlink.Application
+}
+
+// Index lists pipeline fragments, one page at a time.
+func (pfc *PipelineFragmentsController) Index(c *gin.Context, size, page, offset int) {
+	fragments, count, err := pfc.App.PipelineORM().Fragments(c.Request.Context(), offset, size)
+
+	var resources []presenters.PipelineFragmentResource
+	for _, fragment := range fragments {
+		resources = append(resources, *presenters.NewPipelineFragmentResource(fragment))
+	}
+
+	paginatedResponse(c, "PipelineFragments", size, page, resources, count, err)
+}
+
+// Create adds a pipeline fragment.
+func (pfc *PipelineFragmentsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := PipelineFragmentRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	fragment := pipeline.Fragment{Name: request.Name, Source: request.Source}
+	if err := pipeline.ValidateFragment(fragment); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	orm := pfc.App.PipelineORM()
+	_, err := orm.FindFragment(ctx, fragment.Name)
+	if err == nil {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("pipeline fragment %s already exists", fragment.Name))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = orm.CreateFragment(ctx, &fragment); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]any{"name": fragment.Name})
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
+
+// Show returns the details of a pipeline fragment.
+func (pfc *PipelineFragmentsController) Show(c *gin.Context) {
+	fragment, err := pfc.App.PipelineORM().FindFragment(c.Request.Context(), c.Param("Name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
+
+// Destroy removes a pipeline fragment which is not included by any job.
+func (pfc *PipelineFragmentsController) Destroy(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("Name")
+
+	orm := pfc.App.PipelineORM()
+	fragment, err := orm.FindFragment(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for pipeline fragment: %w", err))
+		return
+	}
+	jobsUsingFragment, err := orm.FindJobIDsWithFragment(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %w", err))
+		return
+	}
+	if len(jobsUsingFragment) > 0 {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove the pipeline fragment because jobs %v include it", jobsUsingFragment))
+		return
+	}
+	if err = orm.DeleteFragment(ctx, name); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete pipeline fragment: %w", err))
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]any{"name": name})
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineFragmentsController_CreateShowDestroy(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	body := `{"name": "fetch_price", "source": "fetch [type=http method=GET url=\"{{ .url }}\"];"}`
+	resp, cleanup := client.Post("/v2/pipeline_fragments", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	fragment, err := app.PipelineORM().FindFragment(testutils.Context(t), "fetch_price")
+	require.NoError(t, err)
+	assert.Equal(t, `fetch [type=http method=GET url="{{ .url }}"];`, fragment.Source)
+
+	resp, cleanup = client.Post("/v2/pipeline_fragments", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Get("/v2/pipeline_fragments/fetch_price")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var resource presenters.PipelineFragmentResource
+	cltest.ParseJSONAPIResponse(t, resp, &resource)
+	assert.Equal(t, "fetch_price", resource.Name)
+	assert.Equal(t, fragment.Source, resource.Source)
+
+	resolve := pipeline.NewFragmentResolver(testutils.Context(t), app.PipelineORM())
+	_, err = pipeline.ExpandFragments(`ds [type=fragment name=fetch_price params=<{"url": "https://chain.link"}>]`, resolve)
+	require.NoError(t, err)
+
+	resp, cleanup = client.Delete("/v2/pipeline_fragments/fetch_price")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/pipeline_fragments/fetch_price")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	_, err = pipeline.ExpandFragments(`ds [type=fragment name=fetch_price params=<{"url": "https://chain.link"}>]`, resolve)
+	require.ErrorContains(t, err, "fragment not found")
+}
+
+func TestPipelineFragmentsController_Create_Invalid(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/pipeline_fragments", bytes.NewBufferString("}"))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/pipeline_fragments", bytes.NewBufferString(`{"name": "bad/name", "source": "a [type=memo]"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -1,10 +1,12 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -16,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -68,15 +71,24 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 // "GET <application>/jobs/:ID/runs/:runID"
 func (prc *PipelineRunsController) Show(c *gin.Context) {
 	ctx := c.Request.Context()
+	jobID, err := stringutils.ToInt32(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
 	pipelineRun := pipeline.Run{}
-	err := pipelineRun.SetID(c.Param("runID"))
+	err = pipelineRun.SetID(c.Param("runID"))
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
 
+	// Permissions are scoped by job, so the run must belong to the job of the route
 	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && pipelineRun.PipelineSpec.JobID != jobID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -85,6 +97,147 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// RetryPipelineRunRequest represents a request to retry a pipeline run. Vars are merged into the vars of
+// the retried run, and SkipSucceeded reuses the results of its succeeded tasks.
+type RetryPipelineRunRequest struct {
+	Vars          map[string]any `json:"vars"`
+	SkipSucceeded bool           `json:"skipSucceeded"`
+}
+
+// Retry creates a new run of a job from the vars of one of its runs which finished with errors, linked to it.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/retry"
+func (prc *PipelineRunsController) Retry(c *gin.Context) {
+	ctx := c.Request.Context()
+	jobID, err := stringutils.ToInt32(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	pipelineRun := pipeline.Run{}
+	if err = pipelineRun.SetID(c.Param("runID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request := RetryPipelineRunRequest{}
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if len(bodyBytes) > 0 {
+		if err = json.Unmarshal(bodyBytes, &request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	original, err := prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && original.PipelineSpec.JobID != jobID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jobRunID, err := prc.App.RetryJobRunV2(ctx, original.ID, pipeline.RetryOptions{
+		Vars:          request.Vars,
+		SkipSucceeded: request.SkipSucceeded,
+	})
+	if errors.Is(err, pipeline.ErrRunNotRetryable) || errors.Is(err, pipeline.ErrRetrySpecChanged) || errors.Is(err, pipeline.ErrRetrySentTransaction) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	retry, err := prc.App.PipelineORM().FindRun(ctx, jobRunID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	prc.App.GetAuditLogger().Audit(audit.JobRunRetried, map[string]any{"jobID": jobID, "runID": original.ID, "jobRunID": jobRunID, "skipSucceeded": request.SkipSucceeded})
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(retry, prc.App.GetLogger()), "pipelineRun")
+}
+
+// Export returns a pipeline run with the inputs and outputs of its task runs, in a portable form which can
+// be replayed.
+// Example:
+// "GET <application>/pipeline/runs/:runID/export"
+func (prc *PipelineRunsController) Export(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jb, err := prc.App.JobORM().FindJob(ctx, pipelineRun.PipelineSpec.JobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "failed to find the job of the pipeline run"))
+		return
+	}
+
+	bundle, err := pipeline.NewRunBundle(pipelineRun, jb.ExternalJobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewPipelineRunBundleResource(bundle), "pipelineRunBundle")
+}
+
+// ReplayPipelineRunRequest represents a request to replay an exported pipeline run. RecordedTasks lists
+// the DOT IDs of the tasks which use their recorded output instead of being executed.
+type ReplayPipelineRunRequest struct {
+	Bundle        pipeline.RunBundle `json:"bundle"`
+	RecordedTasks []string           `json:"recordedTasks"`
+}
+
+// Replay executes an exported pipeline run against the current spec of its job, without saving it, and
+// reports how the result of each task differs from the recorded one.
+// Example:
+// "POST <application>/pipeline/runs/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	request := ReplayPipelineRunRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	run, trrs, err := prc.App.ReplayPipelineRun(c.Request.Context(), request.Bundle, request.RecordedTasks)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("job of the pipeline run not found"))
+		return
+	case errors.Is(err, pipeline.ErrUnsupportedRunBundle), errors.Is(err, pipeline.ErrUnknownReplayTask), errors.Is(err, pipeline.ErrNoRecordedTaskRun):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	diffs, err := pipeline.DiffReplayedRun(request.Bundle, trrs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.JobRunReplayed, map[string]any{"jobID": request.Bundle.JobID, "runID": request.Bundle.RunID, "recordedTasks": request.RecordedTasks})
+	jsonAPIResponse(c, presenters.NewPipelineRunReplayResource(request.Bundle.RunID, *run, diffs, prc.App.GetLogger()), "pipelineRunReplay")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -155,6 +308,68 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// CreateSigned triggers a run of a webhook job for a request signed with the job's signing secret,
+// instead of authenticating a user or an external initiator.
+// Example:
+// "POST <application>/webhooks/:ID"
+func (prc *PipelineRunsController) CreateSigned(c *gin.Context) {
+	ctx := c.Request.Context()
+	jobUUID, err := uuid.Parse(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, err := prc.App.JobORM().FindJobByExternalJobID(ctx, jobUUID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && jb.Type != job.Webhook) {
+		jsonAPIError(c, http.StatusNotFound, webhook.ErrJobNotExists)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = webhook.NewRequestVerifier(prc.App.GetDB()).Verify(ctx, jb, c.Request.Header, bodyBytes, time.Now())
+	switch {
+	case errors.Is(err, webhook.ErrRequestSigningDisabled):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, webhook.ErrInvalidSignature), errors.Is(err, webhook.ErrStaleRequest), errors.Is(err, webhook.ErrReplayedRequest):
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = webhook.ValidateRequestBody(*jb.WebhookSpec, bodyBytes); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobRunID, err := prc.App.RunWebhookJobV2(ctx, jobUUID, string(bodyBytes), jsonserializable.JSONSerializable{})
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	pipelineRun, err := prc.App.PipelineORM().FindRun(ctx, jobRunID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.SignedWebhookRunCreated, map[string]any{"jobID": jb.ID, "runID": jobRunID})
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
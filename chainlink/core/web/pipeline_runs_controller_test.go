@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -148,6 +150,84 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_CreateSigned(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	const secret = "0123456789abcdef"
+	externalJobID := uuid.New()
+	{
+		tomlStr := fmt.Sprintf(`
+type              = "webhook"
+schemaVersion     = 1
+externalJobID     = "%s"
+signingSecret     = "%s"
+requestSchema     = '{"type": "object", "required": ["price"]}'
+observationSource = """
+    parse_request [type=jsonparse path="price" data="$(jobRun.requestBody)"];
+"""
+`, externalJobID, secret)
+		jb, err := webhook.ValidatedWebhookSpec(ctx, tomlStr, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		require.NoError(t, app.AddJobV2(ctx, &jb))
+	}
+
+	// Give the job.Spawner ample time to discover the job and start its service
+	time.Sleep(3 * time.Second)
+
+	post := func(t *testing.T, body string, nonce string, sign func(timestamp int64, nonce string, body []byte) string) *http.Response {
+		req, err := http.NewRequestWithContext(testutils.Context(t), "POST", app.Server.URL+"/v2/webhooks/"+externalJobID.String(), strings.NewReader(body))
+		require.NoError(t, err)
+		timestamp := time.Now().Unix()
+		req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(webhook.NonceHeader, nonce)
+		req.Header.Set(webhook.SignatureHeader, sign(timestamp, nonce, []byte(body)))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	signed := func(timestamp int64, nonce string, body []byte) string {
+		return webhook.Sign(secret, timestamp, nonce, body)
+	}
+
+	t.Run("runs the job with the verified payload", func(t *testing.T) {
+		resp := post(t, `{"price": 42}`, "nonce-1", signed)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var run presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &run))
+		require.Len(t, run.TaskRuns, 1)
+		require.NotNil(t, run.TaskRuns[0].Output)
+		assert.Contains(t, *run.TaskRuns[0].Output, "42")
+
+		cltest.AssertServerResponse(t, post(t, `{"price": 42}`, "nonce-1", signed), http.StatusUnauthorized)
+	})
+
+	t.Run("rejects an invalid signature", func(t *testing.T) {
+		resp := post(t, `{"price": 42}`, "nonce-2", func(timestamp int64, nonce string, body []byte) string {
+			return webhook.Sign("fedcba9876543210", timestamp, nonce, body)
+		})
+		cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+	})
+
+	t.Run("rejects a payload which does not match the schema", func(t *testing.T) {
+		cltest.AssertServerResponse(t, post(t, `{"value": 42}`, "nonce-3", signed), http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects unknown jobs", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(testutils.Context(t), "POST", app.Server.URL+"/v2/webhooks/"+uuid.New().String(), nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -240,6 +320,14 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Show_OtherJob(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/jobs/" + strconv.Itoa(int(jobID+1)) + "/runs/" + strconv.FormatInt(runIDs[0], 10))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	app := cltest.NewApplicationEVMDisabled(t)
@@ -251,6 +339,77 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_ExportAndReplay(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/pipeline/runs/" + strconv.FormatInt(runIDs[0], 10) + "/export")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var bundle presenters.PipelineRunBundleResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &bundle))
+	assert.Equal(t, pipeline.RunBundleVersion, bundle.Version)
+	assert.Equal(t, runIDs[0], bundle.RunID)
+	assert.Equal(t, jobID, bundle.JobID)
+	require.Len(t, bundle.TaskRuns, 8)
+
+	body, err := json.Marshal(web.ReplayPipelineRunRequest{Bundle: bundle.RunBundle, RecordedTasks: []string{"ds3"}})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/pipeline/runs/replay", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var replay presenters.PipelineRunReplayResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &replay))
+	assert.Equal(t, strconv.FormatInt(runIDs[0], 10), replay.ID)
+	require.Len(t, replay.Tasks, 8)
+	for _, task := range replay.Tasks {
+		assert.Equal(t, pipeline.TaskReplayUnchanged, task.Status, task.DotID)
+		assert.Equal(t, task.DotID == "ds3", task.UsedRecorded, task.DotID)
+	}
+
+	body, err = json.Marshal(web.ReplayPipelineRunRequest{Bundle: bundle.RunBundle, RecordedTasks: []string{"unknown"}})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/pipeline/runs/replay", bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/pipeline/runs/999999/export")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestPipelineRunsController_Retry(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	retryURL := func(jobID int32, runID int64) string {
+		return fmt.Sprintf("/v2/jobs/%d/runs/%d/retry", jobID, runID)
+	}
+
+	body, err := json.Marshal(web.RetryPipelineRunRequest{SkipSucceeded: true})
+	require.NoError(t, err)
+	response, cleanup := client.Post(retryURL(jobID, runIDs[0]), bytes.NewReader(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	assert.NotEqual(t, strconv.FormatInt(runIDs[0], 10), parsedResponse.ID)
+	assert.Equal(t, runIDs[0], parsedResponse.RetryOfRunID.Int64)
+	assert.Equal(t, []*string{nil}, parsedResponse.FatalErrors)
+	require.Len(t, parsedResponse.TaskRuns, 8)
+
+	// the retry has errors too, so it can be retried in turn, without a body
+	retryID, err := strconv.ParseInt(parsedResponse.ID, 10, 64)
+	require.NoError(t, err)
+	response, cleanup = client.Post(retryURL(jobID, retryID), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	response, cleanup = client.Post(retryURL(jobID+1, runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
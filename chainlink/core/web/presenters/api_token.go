@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// APITokenResource represents a named API token JSONAPI resource, without its secret.
+type APITokenResource struct {
+	JAID
+	Name       string     `json:"name"`
+	Role       string     `json:"role,omitempty"`
+	Scope      []string   `json:"scope"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	// AccessKey and Secret are only returned when the token is created.
+	AccessKey string `json:"accessKey,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:       NewJAID(t.Name),
+		Name:       t.Name,
+		Role:       t.Role.String,
+		Scope:      t.Scope.Strings(),
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt.Ptr(),
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// NewAPITokenResources constructs a slice of APITokenResources
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	ts := []APITokenResource{}
+	for _, token := range tokens {
+		ts = append(ts, *NewAPITokenResource(token))
+	}
+	return ts
+}
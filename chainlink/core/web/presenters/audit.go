@@ -0,0 +1,74 @@
+package presenters
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// AuditEventResource represents an audit event JSONAPI resource.
+type AuditEventResource struct {
+	JAID
+	EventID   audit.EventID   `json:"eventID"`
+	User      string          `json:"user"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"createdAt"`
+	PrevHash  string          `json:"prevHash"`
+	Hash      string          `json:"hash"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditEventResource) GetName() string {
+	return "audit_events"
+}
+
+// NewAuditEventResource constructs a new AuditEventResource
+func NewAuditEventResource(e audit.Event) *AuditEventResource {
+	return &AuditEventResource{
+		JAID:      NewJAID(strconv.FormatInt(e.ID, 10)),
+		EventID:   e.EventID,
+		User:      e.UserEmail,
+		Data:      json.RawMessage(e.Data),
+		CreatedAt: e.CreatedAt,
+		PrevHash:  e.PrevHash,
+		Hash:      e.Hash,
+	}
+}
+
+// NewAuditEventResources constructs a slice of AuditEventResources
+func NewAuditEventResources(events []audit.Event) []AuditEventResource {
+	rs := []AuditEventResource{}
+	for _, e := range events {
+		rs = append(rs, *NewAuditEventResource(e))
+	}
+	return rs
+}
+
+// AuditVerifyResource represents the result of verifying the audit event hash chain.
+type AuditVerifyResource struct {
+	JAID
+	Valid          bool   `json:"valid"`
+	Events         int64  `json:"events"`
+	LastHash       string `json:"lastHash"`
+	FirstInvalidID int64  `json:"firstInvalidID,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditVerifyResource) GetName() string {
+	return "audit_verifications"
+}
+
+// NewAuditVerifyResource constructs a new AuditVerifyResource
+func NewAuditVerifyResource(r audit.VerifyResult) *AuditVerifyResource {
+	return &AuditVerifyResource{
+		JAID:           NewJAID("verify"),
+		Valid:          r.Valid(),
+		Events:         r.Events,
+		LastHash:       r.LastHash,
+		FirstInvalidID: r.FirstInvalidID,
+		Reason:         r.Reason,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 )
 
@@ -14,10 +15,17 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken          string           `json:"incomingToken,omitempty"`
+	OutgoingToken          string           `json:"outgoingToken"`
+	MinimumContractPayment *assets.Link     `json:"minimumContractPayment"`
+	CreatedAt              time.Time        `json:"createdAt"`
+	FallbackURLs           []string         `json:"fallbackURLs,omitempty"`
+	HealthCheckPath        string           `json:"healthCheckPath,omitempty"`
+	HealthCheckInterval    sqlutil.Interval `json:"healthCheckInterval,omitempty"`
+	RequestSchema          string           `json:"requestSchema,omitempty"`
+	ResponseSchema         string           `json:"responseSchema,omitempty"`
+	// Endpoints is the health of the bridge endpoints, in failover order.
+	Endpoints []bridges.EndpointHealth `json:"endpoints"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -26,7 +34,11 @@ func (r BridgeResource) GetName() string {
 }
 
 // NewBridgeResource constructs a new BridgeResource
-func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
+func NewBridgeResource(b bridges.BridgeType, health []bridges.EndpointHealth) *BridgeResource {
+	var fallbackURLs []string
+	for _, u := range b.FallbackURLs {
+		fallbackURLs = append(fallbackURLs, u.String())
+	}
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
@@ -36,5 +48,11 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
+		FallbackURLs:           fallbackURLs,
+		HealthCheckPath:        b.HealthCheckPath,
+		HealthCheckInterval:    b.HealthCheckInterval,
+		RequestSchema:          b.RequestSchema,
+		ResponseSchema:         b.ResponseSchema,
+		Endpoints:              health,
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
@@ -18,6 +19,8 @@ func TestBridgeResource(t *testing.T) {
 	t.Parallel()
 
 	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	fallbackURL, err := url.Parse("https://fallback.example.com/api")
+	require.NoError(t, err)
 	url, err := url.Parse("https://bridge.example.com/api")
 	require.NoError(t, err)
 
@@ -27,10 +30,17 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
+		FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
+		HealthCheckPath:        "/health",
+		HealthCheckInterval:    *sqlutil.NewInterval(10 * time.Second),
 		CreatedAt:              timestamp,
 	}
+	health := []bridges.EndpointHealth{
+		{URL: "https://bridge.example.com/api", Status: bridges.EndpointStatusUnhealthy, Error: "health check returned status 503", CheckedAt: &timestamp},
+		{URL: "https://fallback.example.com/api", Status: bridges.EndpointStatusHealthy, CheckedAt: &timestamp},
+	}
 
-	r := NewBridgeResource(bridge)
+	r := NewBridgeResource(bridge, health)
 
 	b, err := jsonapi.Marshal(r)
 	require.NoError(t, err)
@@ -46,7 +56,14 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
-			"createdAt":"2000-01-01T00:00:00Z"
+			"createdAt":"2000-01-01T00:00:00Z",
+			"fallbackURLs":["https://fallback.example.com/api"],
+			"healthCheckPath":"/health",
+			"healthCheckInterval":"10s",
+			"endpoints":[
+				{"url":"https://bridge.example.com/api","status":"unhealthy","error":"health check returned status 503","checkedAt":"2000-01-01T00:00:00Z"},
+				{"url":"https://fallback.example.com/api","status":"healthy","checkedAt":"2000-01-01T00:00:00Z"}
+			]
 		}
 	}
 }
@@ -71,7 +88,14 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
-			"createdAt":"2000-01-01T00:00:00Z"
+			"createdAt":"2000-01-01T00:00:00Z",
+			"fallbackURLs":["https://fallback.example.com/api"],
+			"healthCheckPath":"/health",
+			"healthCheckInterval":"10s",
+			"endpoints":[
+				{"url":"https://bridge.example.com/api","status":"unhealthy","error":"health check returned status 503","checkedAt":"2000-01-01T00:00:00Z"},
+				{"url":"https://fallback.example.com/api","status":"healthy","checkedAt":"2000-01-01T00:00:00Z"}
+			]
 		}
 	}
 }
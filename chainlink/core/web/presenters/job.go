@@ -43,6 +43,7 @@ const (
 	WorkflowJobSpec             JobSpecType = "workflow"
 	StandardCapabilitiesJobSpec JobSpecType = "standardcapabilities"
 	CCIPSJobSpec                JobSpecType = "ccip"
+	LogTriggerJobSpec           JobSpecType = "logtrigger"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -243,33 +244,43 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	SignedRequests bool      `json:"signedRequests"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
 }
 
-// NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
+// NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec. The signing secret is never exposed.
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		SignedRequests: spec.SigningSecret.Valid,
+		CreatedAt:      spec.CreatedAt,
+		UpdatedAt:      spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule     string                `json:"schedule"`
+	MissedTickPolicy job.MissedTickPolicy  `json:"missedTickPolicy"`
+	MaxCatchUpRuns   uint32                `json:"maxCatchUpRuns"`
+	Jitter           commonconfig.Duration `json:"jitter"`
+	LastFiredAt      *time.Time            `json:"lastFiredAt"`
+	CreatedAt        time.Time             `json:"createdAt"`
+	UpdatedAt        time.Time             `json:"updatedAt"`
+	EVMChainID       *big.Big              `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:     spec.CronSchedule,
+		MissedTickPolicy: spec.MissedTickPolicy,
+		MaxCatchUpRuns:   spec.MaxCatchUpRuns,
+		Jitter:           *commonconfig.MustNewDuration(spec.Jitter),
+		LastFiredAt:      spec.LastFiredAt,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+		EVMChainID:       spec.EVMChainID,
 	}
 }
 
@@ -493,6 +504,42 @@ func NewCCIPSpec(spec *job.CCIPSpec) *CCIPSpec {
 	}
 }
 
+// LogTriggerSpec defines the spec details of a LogTrigger Job
+type LogTriggerSpec struct {
+	ContractAddress  types.EIP55Address    `json:"contractAddress"`
+	EventABI         string                `json:"eventABI"`
+	Topic1           []string              `json:"topic1"`
+	Topic2           []string              `json:"topic2"`
+	Topic3           []string              `json:"topic3"`
+	MinConfirmations uint32                `json:"minConfirmations"`
+	FromBlock        int64                 `json:"fromBlock"`
+	PollPeriod       commonconfig.Duration `json:"pollPeriod"`
+	CursorBlock      *int64                `json:"cursorBlock"`
+	CursorLogIndex   int64                 `json:"cursorLogIndex"`
+	EVMChainID       *big.Big              `json:"evmChainID"`
+	CreatedAt        time.Time             `json:"createdAt"`
+	UpdatedAt        time.Time             `json:"updatedAt"`
+}
+
+// NewLogTriggerSpec generates a new LogTriggerSpec from a job.LogTriggerSpec
+func NewLogTriggerSpec(spec *job.LogTriggerSpec) *LogTriggerSpec {
+	return &LogTriggerSpec{
+		ContractAddress:  spec.ContractAddress,
+		EventABI:         spec.EventABI,
+		Topic1:           spec.Topic1,
+		Topic2:           spec.Topic2,
+		Topic3:           spec.Topic3,
+		MinConfirmations: spec.MinConfirmations,
+		FromBlock:        spec.FromBlock,
+		PollPeriod:       *commonconfig.MustNewDuration(spec.PollPeriod),
+		CursorBlock:      spec.CursorBlock.Ptr(),
+		CursorLogIndex:   spec.CursorLogIndex,
+		EVMChainID:       spec.EVMChainID,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+	}
+}
+
 type CRESettingsSpec struct {
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -539,6 +586,7 @@ type JobResource struct {
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
 	MaxTaskDuration          sqlutil.Interval          `json:"maxTaskDuration"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
+	State                    job.State                 `json:"state,omitempty"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
 	FluxMonitorSpec          *FluxMonitorSpec          `json:"fluxMonitorSpec"`
 	CRESettings              *CRESettingsSpec          `json:"creSettingsSpec"`
@@ -555,6 +603,7 @@ type JobResource struct {
 	WorkflowSpec             *WorkflowSpec             `json:"workflowSpec"`
 	StandardCapabilitiesSpec *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
+	LogTriggerSpec           *LogTriggerSpec           `json:"logTriggerSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
 	Errors                   []JobError                `json:"errors"`
 }
@@ -572,6 +621,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		State:             j.State,
 	}
 
 	switch j.Type {
@@ -609,6 +659,8 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.StandardCapabilitiesSpec = NewStandardCapabilitiesSpec(j.StandardCapabilitiesSpec)
 	case job.CCIP:
 		resource.CCIPSpec = NewCCIPSpec(j.CCIPSpec)
+	case job.LogTrigger:
+		resource.LogTriggerSpec = NewLogTriggerSpec(j.LogTriggerSpec)
 	case job.LegacyGasStationServer, job.LegacyGasStationSidecar:
 		// unsupported
 	}
@@ -626,3 +678,59 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecRevisionResource represents an accepted revision of a job spec. Its
+// id is the revision number, which is unique within the job.
+type JobSpecRevisionResource struct {
+	JAID
+	JobID     int32     `json:"jobID"`
+	Revision  int32     `json:"revision"`
+	TOML      string    `json:"toml"`
+	Author    string    `json:"author"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewJobSpecRevisionResource initializes a new JSONAPI job spec revision resource
+func NewJobSpecRevisionResource(rev job.SpecRevision) *JobSpecRevisionResource {
+	return &JobSpecRevisionResource{
+		JAID:      NewJAIDInt32(rev.Revision),
+		JobID:     rev.JobID,
+		Revision:  rev.Revision,
+		TOML:      rev.TOML,
+		Author:    rev.Author,
+		Comment:   rev.Comment,
+		CreatedAt: rev.CreatedAt,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecRevisionResource) GetName() string {
+	return "jobSpecRevisions"
+}
+
+// JobSpecValidationResource is the result of validating a job spec without creating the job.
+type JobSpecValidationResource struct {
+	JAID
+	Valid  bool                  `json:"valid"`
+	Type   JobSpecType           `json:"type"`
+	Errors []job.ValidationError `json:"errors"`
+}
+
+// NewJobSpecValidationResource initializes a new JSONAPI job spec validation resource
+func NewJobSpecValidationResource(jobType job.Type, errs []job.ValidationError) *JobSpecValidationResource {
+	if errs == nil {
+		errs = []job.ValidationError{}
+	}
+	return &JobSpecValidationResource{
+		JAID:   NewJAID("job_spec_validation"),
+		Valid:  len(errs) == 0,
+		Type:   JobSpecType(jobType),
+		Errors: errs,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecValidationResource) GetName() string {
+	return "jobSpecValidations"
+}
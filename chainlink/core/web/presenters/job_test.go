@@ -131,6 +131,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -211,6 +212,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -301,6 +303,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -368,6 +371,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -380,10 +384,13 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
-					EVMChainID:   evmChainID,
+					CronSchedule:     cronSchedule,
+					MissedTickPolicy: job.MissedTickPolicyRunAll,
+					MaxCatchUpRuns:   5,
+					Jitter:           10 * time.Second,
+					CreatedAt:        timestamp,
+					UpdatedAt:        timestamp,
+					EVMChainID:       evmChainID,
 				},
 				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -413,6 +420,10 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "missedTickPolicy": "runAll",
+                            "maxCatchUpRuns": 5,
+                            "jitter": "10s",
+                            "lastFiredAt": null,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z",
                             "evmChainID":"42"
@@ -432,6 +443,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
                         "errors": []
@@ -474,6 +486,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"signedRequests": false,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -492,6 +505,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -581,6 +595,7 @@ func TestJob(t *testing.T) {
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -665,6 +680,7 @@ func TestJob(t *testing.T) {
 						},
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -749,6 +765,7 @@ func TestJob(t *testing.T) {
 						},
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -817,6 +834,7 @@ func TestJob(t *testing.T) {
 						},
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"errors": []
@@ -877,6 +895,7 @@ func TestJob(t *testing.T) {
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"pipelineSpec": {
@@ -943,6 +962,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"pipelineSpec": {
@@ -1005,6 +1025,7 @@ func TestJob(t *testing.T) {
 							"createdAt":"0001-01-01T00:00:00Z",
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"creSettingsSpec": null,
 						"pipelineSpec": {
@@ -1064,6 +1085,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": {
 							"capabilityVersion":"4.5.9",
 							"capabilityLabelledName":"ccip",
@@ -1135,6 +1157,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"pipelineSpec": {
 							"id": 1,
@@ -1216,6 +1239,7 @@ func TestJob(t *testing.T) {
 						"bootstrapSpec": null,
 						"gatewaySpec": null,
 						"standardCapabilitiesSpec": null,
+						"logTriggerSpec": null,
 						"ccipSpec": null,
 						"errors": [{
 							"id": 200,
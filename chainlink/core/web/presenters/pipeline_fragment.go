@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResource represents a pipeline fragment JSONAPI resource.
+type PipelineFragmentResource struct {
+	JAID
+	Name      string    `json:"name"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineFragmentResource) GetName() string {
+	return "pipelineFragments"
+}
+
+// NewPipelineFragmentResource constructs a new PipelineFragmentResource
+func NewPipelineFragmentResource(f pipeline.Fragment) *PipelineFragmentResource {
+	return &PipelineFragmentResource{
+		JAID:      NewJAID(f.Name),
+		Name:      f.Name,
+		Source:    f.Source,
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+}
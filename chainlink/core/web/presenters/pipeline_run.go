@@ -24,6 +24,7 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                         `json:"createdAt"`
 	FinishedAt   null.Time                         `json:"finishedAt"`
 	PipelineSpec PipelineSpec                      `json:"pipelineSpec"`
+	RetryOfRunID null.Int                          `json:"retryOfRunID"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -56,6 +57,7 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt,
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		RetryOfRunID: pr.RetryOfRunID,
 	}
 }
 
@@ -104,3 +106,52 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunBundleResource is a pipeline run exported to be replayed
+type PipelineRunBundleResource struct {
+	JAID
+	pipeline.RunBundle
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunBundleResource) GetName() string {
+	return "pipelineRunBundle"
+}
+
+func NewPipelineRunBundleResource(bundle pipeline.RunBundle) PipelineRunBundleResource {
+	return PipelineRunBundleResource{
+		JAID:      NewJAIDInt64(bundle.RunID),
+		RunBundle: bundle,
+	}
+}
+
+// PipelineRunReplayResource is the outcome of replaying an exported pipeline run. Its ID is the ID of the
+// exported run, since replays are not saved.
+type PipelineRunReplayResource struct {
+	JAID
+	State       pipeline.RunStatus        `json:"state"`
+	Outputs     []*string                 `json:"outputs"`
+	AllErrors   []*string                 `json:"allErrors"`
+	FatalErrors []*string                 `json:"fatalErrors"`
+	Tasks       []pipeline.TaskReplayDiff `json:"tasks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+func NewPipelineRunReplayResource(runID int64, run pipeline.Run, diffs []pipeline.TaskReplayDiff, lggr logger.Logger) PipelineRunReplayResource {
+	outputs, err := run.StringOutputs()
+	if err != nil {
+		lggr.Named("PipelineRunReplayResource").Errorw(err.Error(), "out", run.Outputs)
+	}
+	return PipelineRunReplayResource{
+		JAID:        NewJAIDInt64(runID),
+		State:       run.State,
+		Outputs:     outputs,
+		AllErrors:   run.StringAllErrors(),
+		FatalErrors: run.StringFatalErrors(),
+		Tasks:       diffs,
+	}
+}
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// RoleResource represents a custom role JSONAPI resource.
+type RoleResource struct {
+	JAID
+	Name        string    `json:"name"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RoleResource) GetName() string {
+	return "roles"
+}
+
+// NewRoleResource constructs a new RoleResource
+func NewRoleResource(r sessions.Role) *RoleResource {
+	return &RoleResource{
+		JAID:        NewJAID(r.Name),
+		Name:        r.Name,
+		Permissions: r.Permissions.Strings(),
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// NewRoleResources constructs a slice of RoleResources
+func NewRoleResources(roles []sessions.Role) []RoleResource {
+	rs := []RoleResource{}
+	for _, role := range roles {
+		rs = append(rs, *NewRoleResource(role))
+	}
+	return rs
+}
@@ -2,13 +2,19 @@ package resolver
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
-// Authenticates the user from the session cookie, presence of user inherently provides 'view' access.
+// Authenticates the user from the session cookie, presence of user inherently provides access to the user's own
+// account.
 func authenticateUser(ctx context.Context) error {
 	if _, ok := auth.GetGQLAuthenticatedSession(ctx); !ok {
 		return unauthorizedError{}
@@ -16,42 +22,114 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts at least 'view' permission on the resource.
+func authenticateUserCanView(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.ActionView, noScope)
+}
+
+// Authenticates the user from the session cookie and asserts at least 'run' permission on the resource.
+func authenticateUserCanRun(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.ActionRun, noScope)
+}
+
+// Authenticates the user from the session cookie and asserts at least 'edit' permission on the resource.
+func authenticateUserCanEdit(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.ActionEdit, noScope)
+}
+
+// Authenticates the user from the session cookie and asserts 'admin' permission on the resource.
+func authenticateUserIsAdmin(ctx context.Context, resource sessions.Resource) error {
+	return authorizeUser(ctx, resource, sessions.ActionAdmin, noScope)
+}
+
+// scopeFunc returns the scope of the resource a request acts on, which is empty if it has none.
+type scopeFunc func() (string, error)
+
+func noScope() (string, error) { return "", nil }
+
+// authorizeUser asserts the user is permitted action on resource. The scope is only resolved when the user has no
+// unscoped permission, since resolving it may need a lookup.
+func authorizeUser(ctx context.Context, resource sessions.Resource, action sessions.Action, scope scopeFunc) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
+	if session.User.Can(resource, action, "") {
+		return nil
+	}
+	s, err := scope()
+	if err != nil {
+		return err
+	}
+	if s == "" || !session.User.Can(resource, action, s) {
+		return RoleNotPermittedErr{sessions.UserRole(session.User.RoleName())}
 	}
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
+// bridgeScope returns the scope of the bridge named id.
+func bridgeScope(id graphql.ID) scopeFunc {
+	return func() (string, error) { return string(id), nil }
+}
+
+// jobScope returns the scope of the job with id, which is its external job ID. Jobs which do not exist have no
+// scope.
+func (r *Resolver) jobScope(ctx context.Context, id graphql.ID) scopeFunc {
+	return func() (string, error) {
+		jobID, err := stringutils.ToInt32(string(id))
+		if err != nil {
+			// invalid IDs are reported by the resolver
+			return "", nil //nolint:nilerr
+		}
+		return r.externalJobID(ctx, jobID)
 	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
+}
+
+// jobRunScope returns the scope of the job of the run with id.
+func (r *Resolver) jobRunScope(ctx context.Context, id graphql.ID) scopeFunc {
+	return func() (string, error) {
+		runID, err := stringutils.ToInt64(string(id))
+		if err != nil {
+			return "", nil //nolint:nilerr
+		}
+		run, err := r.App.JobORM().FindPipelineRunByID(ctx, runID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+			return "", err
+		}
+		return r.externalJobID(ctx, run.PipelineSpec.JobID)
 	}
-	return nil
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
+// jobErrorScope returns the scope of the job of the spec error with id.
+func (r *Resolver) jobErrorScope(ctx context.Context, id graphql.ID) scopeFunc {
+	return func() (string, error) {
+		specErrID, err := stringutils.ToInt64(string(id))
+		if err != nil {
+			return "", nil //nolint:nilerr
+		}
+		specErr, err := r.App.JobORM().FindSpecError(ctx, specErrID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+			return "", err
+		}
+		return r.externalJobID(ctx, specErr.JobID)
 	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
+}
+
+func (r *Resolver) externalJobID(ctx context.Context, jobID int32) (string, error) {
+	jb, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
 	}
-	return nil
+	return jb.ExternalJobID.String(), nil
 }
 
 type unauthorizedError struct{}
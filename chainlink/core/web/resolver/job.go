@@ -10,6 +10,23 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
+// JobState represents the state of a job
+type JobState string
+
+const (
+	JobStateActive JobState = "ACTIVE"
+	JobStatePaused JobState = "PAUSED"
+)
+
+// ToJobState converts a job state into its GQL enum value. Jobs without an
+// explicit state are active.
+func ToJobState(s job.State) JobState {
+	if s == job.StatePaused {
+		return JobStatePaused
+	}
+	return JobStateActive
+}
+
 // JobResolver resolves the Job type.
 type JobResolver struct {
 	app chainlink.Application
@@ -96,6 +113,11 @@ func (r *JobResolver) Type() string {
 	return string(r.j.Type)
 }
 
+// State resolves the job's state.
+func (r *JobResolver) State() JobState {
+	return ToJobState(r.j.State)
+}
+
 // Spec resolves the job's spec.
 func (r *JobResolver) Spec() *SpecResolver {
 	return NewSpec(r.j)
@@ -254,3 +276,73 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// -- PauseJob Mutation --
+
+type PauseJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewPauseJobPayload(app chainlink.Application, j *job.Job, err error) *PauseJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &PauseJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobSuccess() (*PauseJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewPauseJobSuccess(r.app, r.j), true
+}
+
+type PauseJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewPauseJobSuccess(app chainlink.Application, job *job.Job) *PauseJobSuccessResolver {
+	return &PauseJobSuccessResolver{app: app, j: job}
+}
+
+func (r *PauseJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- ResumeJob Mutation --
+
+type ResumeJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewResumeJobPayload(app chainlink.Application, j *job.Job, err error) *ResumeJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &ResumeJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobSuccess() (*ResumeJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewResumeJobSuccess(r.app, r.j), true
+}
+
+type ResumeJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewResumeJobSuccess(app chainlink.Application, job *job.Job) *ResumeJobSuccessResolver {
+	return &ResumeJobSuccessResolver{app: app, j: job}
+}
+
+func (r *ResumeJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
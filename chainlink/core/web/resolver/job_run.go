@@ -140,6 +140,15 @@ func (r *JobRunResolver) Job(ctx context.Context) (*JobResolver, error) {
 	return NewJob(r.app, *job), nil
 }
 
+// RetryOfRunID resolves the ID of the run this run retries, if any
+func (r *JobRunResolver) RetryOfRunID() *graphql.ID {
+	if !r.run.RetryOfRunID.Valid {
+		return nil
+	}
+	id := int64GQLID(r.run.RetryOfRunID.Int64)
+	return &id
+}
+
 func (r *JobRunResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.run.CreatedAt}
 }
@@ -267,3 +276,55 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- RetryJobRun Mutation --
+
+type RetryJobRunPayloadResolver struct {
+	run *pipeline.Run
+	app chainlink.Application
+	NotFoundErrorUnionType
+}
+
+func NewRetryJobRunPayload(run *pipeline.Run, app chainlink.Application, err error) *RetryJobRunPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job run not found", isExpectedErrorFn: nil}
+
+	return &RetryJobRunPayloadResolver{run: run, app: app, NotFoundErrorUnionType: e}
+}
+
+func (r *RetryJobRunPayloadResolver) ToRetryJobRunSuccess() (*RetryJobRunSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &RetryJobRunSuccessResolver{run: *r.run, app: r.app}, true
+}
+
+func (r *RetryJobRunPayloadResolver) ToRetryJobRunCannotRetryError() (*RetryJobRunCannotRetryErrorResolver, bool) {
+	if r.err == nil || isNotFoundError(r.err) {
+		return nil, false
+	}
+
+	return &RetryJobRunCannotRetryErrorResolver{message: r.err.Error(), code: ErrorCodeUnprocessable}, true
+}
+
+type RetryJobRunSuccessResolver struct {
+	run pipeline.Run
+	app chainlink.Application
+}
+
+func (r *RetryJobRunSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(r.run, r.app)
+}
+
+type RetryJobRunCannotRetryErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *RetryJobRunCannotRetryErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func (r *RetryJobRunCannotRetryErrorResolver) Message() string {
+	return r.message
+}
@@ -399,3 +399,102 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_RetryJobRun(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RetryJobRun($id: ID!, $input: RetryJobRunInput!) {
+			retryJobRun(id: $id, input: $input) {
+				... on RetryJobRunSuccess {
+					jobRun {
+						id
+						status
+						retryOfRunID
+					}
+				}
+				... on RetryJobRunCannotRetryError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]any{
+		"id": "7",
+		"input": map[string]any{
+			"skipSucceeded": true,
+			"vars":          `{"jobRun": {"requestBody": "{}"}}`,
+		},
+	}
+	opts := pipeline.RetryOptions{
+		SkipSucceeded: true,
+		Vars:          map[string]any{"jobRun": map[string]any{"requestBody": "{}"}},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "retryJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(7), opts).Return(int64(8), nil)
+				f.Mocks.pipelineORM.On("FindRun", mock.Anything, int64(8)).Return(pipeline.Run{
+					ID:           8,
+					State:        pipeline.RunStatusCompleted,
+					RetryOfRunID: null.IntFrom(7),
+				}, nil)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"retryJobRun": {
+						"jobRun": {
+							"id": "8",
+							"status": "COMPLETED",
+							"retryOfRunID": "7"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(7), opts).Return(int64(0), sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"retryJobRun": {
+						"code": "NOT_FOUND",
+						"message": "job run not found"
+					}
+				}`,
+		},
+		{
+			name:          "run cannot be retried",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("RetryJobRunV2", mock.Anything, int64(7), opts).Return(int64(0), pipeline.ErrRunNotRetryable)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"retryJobRun": {
+						"code": "UNPROCESSABLE",
+						"message": "only finished runs with errors can be retried"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -10,9 +10,11 @@ import (
 
 	"github.com/google/uuid"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/graph-gophers/graphql-go/gqltesting"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -22,8 +24,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // This tests the main fields on the job results. Embedded spec testing is done
@@ -138,6 +143,69 @@ func TestResolver_Jobs(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_Job_ScopedPermission(t *testing.T) {
+	externalJobID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	permissions, err := clsessions.ParsePermissions([]string{"jobs:view:" + externalJobID.String()})
+	require.NoError(t, err)
+	user := clsessions.User{
+		Email:       "gqltester@chain.link",
+		Role:        clsessions.UserRoleView,
+		CustomRole:  null.StringFrom("job-viewer"),
+		Permissions: permissions,
+	}
+	query := `
+		query GetJob {
+			job(id: "1") {
+				... on Job {
+					externalJobID
+				}
+			}
+		}`
+
+	for _, tc := range []struct {
+		name          string
+		externalJobID uuid.UUID
+		result        string
+		errors        []*gqlerrors.QueryError
+	}{
+		{
+			name:          "permitted on the job",
+			externalJobID: externalJobID,
+			result:        `{"job": {"externalJobID": "00000000-0000-0000-0000-000000000001"}}`,
+		},
+		{
+			name:          "not permitted on another job",
+			externalJobID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			result:        "null",
+			errors: []*gqlerrors.QueryError{{
+				ResolverError: RoleNotPermittedErr{Role: "job-viewer"},
+				Path:          []any{"job"},
+				Message:       "Not permitted with current role: job-viewer",
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := setupFramework(t)
+			ctx := loader.InjectDataloader(testutils.Context(t), f.App)
+			ctx = auth.WithGQLAuthenticatedSession(ctx, user, "gqltesterSession")
+
+			f.App.On("JobORM").Return(f.Mocks.jobORM)
+			f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, int32(1)).Return(job.Job{
+				ID:            1,
+				ExternalJobID: tc.externalJobID,
+			}, nil)
+
+			gqltesting.RunTest(t, &gqltesting.Test{
+				Context:        ctx,
+				Schema:         f.RootSchema,
+				Query:          query,
+				ExpectedResult: tc.result,
+				ExpectedErrors: tc.errors,
+			})
+		})
+	}
+}
+
 func TestResolver_Job(t *testing.T) {
 	var (
 		id            = int32(1)
@@ -355,7 +423,13 @@ func TestResolver_CreateJob(t *testing.T) {
 			authenticated: true,
 			before: func(ctx context.Context, f *gqlTestFramework) {
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("InsertSpecRevision", mock.Anything, &job.SpecRevision{
+					TOML:   spec,
+					Author: "gqltester@chain.link",
+				}).Return(nil)
 			},
 			query:     mutation,
 			variables: variables,
@@ -364,8 +438,11 @@ func TestResolver_CreateJob(t *testing.T) {
 		{
 			name:          "invalid TOML error",
 			authenticated: true,
-			query:         mutation,
-			variables:     invalid,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query:     mutation,
+			variables: invalid,
 			result: `
 				{
 					"createJob": {
@@ -382,6 +459,7 @@ func TestResolver_CreateJob(t *testing.T) {
 			authenticated: true,
 			before: func(ctx context.Context, f *gqlTestFramework) {
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(gError)
 			},
 			query:     mutation,
@@ -396,6 +474,28 @@ func TestResolver_CreateJob(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "error when recording the spec revision",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("InsertSpecRevision", mock.Anything, mock.Anything).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: fmt.Errorf("job 0 was saved, but its spec revision could not be recorded: %w", gError),
+					Path:          []any{"createJob"},
+					Message:       "job 0 was saved, but its spec revision could not be recorded: error",
+				},
+			},
+		},
 	}
 
 	RunGQLTests(t, testCases)
@@ -564,3 +664,172 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						name
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]any{
+		"id": "123",
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobSpawner.On("PauseJob", mock.Anything, id).Return(nil)
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:    id,
+					Name:  null.StringFrom("test-job"),
+					State: job.StatePaused,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"name": "test-job",
+							"state": "PAUSED"
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobSpawner.On("PauseJob", mock.Anything, id).Return(fmt.Errorf("failed to pause job %d: %w", id, sql.ErrNoRows))
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "generic error on PauseJob()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobSpawner.On("PauseJob", mock.Anything, id).Return(gError)
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []any{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]any{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobSpawner.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
+					ID:    id,
+					State: job.StateActive,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"state": "ACTIVE"
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobSpawner.On("ResumeJob", mock.Anything, id).Return(fmt.Errorf("failed to find job %d: %w", id, sql.ErrNoRows))
+				f.App.On("JobSpawner").Return(f.Mocks.jobSpawner)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -36,14 +36,17 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/logtrigger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -64,7 +67,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -114,7 +117,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -138,7 +141,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -181,7 +184,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -266,7 +269,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -322,7 +325,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -406,7 +409,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -459,7 +462,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionEdit, bridgeScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -498,6 +501,13 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// The endpoints failover configuration and the schemas are not part of the input, keep them unchanged
+	btr.FallbackURLs = bridge.FallbackURLs
+	btr.HealthCheckPath = bridge.HealthCheckPath
+	btr.HealthCheckInterval = bridge.HealthCheckInterval
+	btr.RequestSchema = bridge.RequestSchema
+	btr.ResponseSchema = bridge.ResponseSchema
+
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
@@ -527,7 +537,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -575,7 +585,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -597,7 +607,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -616,7 +626,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -636,7 +646,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -655,7 +665,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionEdit, bridgeScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -691,7 +701,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -715,7 +725,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -741,7 +751,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -763,7 +773,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -788,7 +798,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -827,7 +837,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -862,7 +872,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -898,7 +908,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -972,7 +982,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -1060,14 +1070,22 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 
 func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	Input struct {
-		TOML string
+		TOML    string
+		Comment *string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	tomlString, err := job.ExpandFragments(args.Input.TOML, pipeline.NewFragmentResolver(ctx, r.App.PipelineORM()))
+	if err != nil {
+		return NewCreateJobPayload(r.App, nil, map[string]string{
+			"TOML spec": errors.Wrap(err, "failed to expand pipeline fragments").Error(),
+		}), nil
+	}
+
+	jbt, err := job.ValidateSpec(tomlString)
 	if err != nil {
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
@@ -1078,45 +1096,47 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), tomlString)
 		if !config.OCR().Enabled() {
 			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), args.Input.TOML, r.App.GetLoopRegistrarConfig())
+		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), tomlString, r.App.GetLoopRegistrarConfig())
 		if !config.OCR2().Enabled() {
 			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.CRESettings:
-		jb, err = cresettings.ValidatedCRESettingsSpec(args.Input.TOML)
+		jb, err = cresettings.ValidatedCRESettingsSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, r.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.BlockHeaderFeeder:
-		jb, err = blockheaderfeeder.ValidatedSpec(args.Input.TOML)
+		jb, err = blockheaderfeeder.ValidatedSpec(tomlString)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
 	case job.Gateway:
-		jb, err = gateway.ValidatedGatewaySpec(args.Input.TOML)
+		jb, err = gateway.ValidatedGatewaySpec(tomlString)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, args.Input.TOML)
+		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, tomlString)
 	case job.StandardCapabilities:
-		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(args.Input.TOML)
+		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(tomlString)
 	case job.Stream:
-		jb, err = streams.ValidatedStreamSpec(args.Input.TOML)
+		jb, err = streams.ValidatedStreamSpec(tomlString)
 	case job.CCIP:
-		jb, err = ccip.ValidatedCCIPSpec(args.Input.TOML)
+		jb, err = ccip.ValidatedCCIPSpec(tomlString)
+	case job.LogTrigger:
+		jb, err = logtrigger.ValidatedLogTriggerSpec(tomlString)
 	default:
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
@@ -1134,6 +1154,24 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// the revision keeps the pipeline the job was saved with, so that included fragments are kept as they were expanded
+	rev := job.SpecRevision{JobID: jb.ID, TOML: args.Input.TOML}
+	if args.Input.Comment != nil {
+		rev.Comment = *args.Input.Comment
+	}
+	if session, ok := webauth.GetGQLAuthenticatedSession(ctx); ok {
+		rev.Author = session.User.Email
+	}
+	if jb.Pipeline.Source != "" {
+		rev.TOML, err = job.ReplaceObservationSource(args.Input.TOML, jb.Pipeline.Source)
+	}
+	if err == nil {
+		err = r.App.JobORM().InsertSpecRevision(ctx, &rev)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("job %d was saved, but its spec revision could not be recorded: %w", jb.ID, err)
+	}
+
 	jbj, _ := json.Marshal(jb)
 	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]any{"job": string(jbj)})
 
@@ -1143,7 +1181,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit, r.jobScope(ctx, args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1174,10 +1212,72 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+// PauseJob stops the services of a job without deleting it.
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit, r.jobScope(ctx, args.ID)); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.JobSpawner().PauseJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]any{"id": args.ID})
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+// ResumeJob restarts the services of a paused job.
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit, r.jobScope(ctx, args.ID)); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.JobSpawner().ResumeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]any{"id": args.ID})
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionEdit, r.jobErrorScope(ctx, args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1211,7 +1311,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionRun, r.jobScope(ctx, args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -1238,10 +1338,54 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+type retryJobRunInput struct {
+	SkipSucceeded bool
+	Vars          *string
+}
+
+// RetryJobRun starts a new run of the job of a run which finished with errors, from the vars of that run.
+func (r *Resolver) RetryJobRun(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input retryJobRunInput
+}) (*RetryJobRunPayloadResolver, error) {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionRun, r.jobRunScope(ctx, args.ID)); err != nil {
+		return nil, err
+	}
+
+	runID, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := pipeline.RetryOptions{SkipSucceeded: args.Input.SkipSucceeded}
+	if args.Input.Vars != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Vars), &opts.Vars); err != nil {
+			return NewRetryJobRunPayload(nil, r.App, errors.Wrap(err, "invalid vars")), nil
+		}
+	}
+
+	jobRunID, err := r.App.RetryJobRunV2(ctx, runID, opts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pipeline.ErrRunNotRetryable) || errors.Is(err, pipeline.ErrRetrySpecChanged) || errors.Is(err, pipeline.ErrRetrySentTransaction) {
+			return NewRetryJobRunPayload(nil, r.App, err), nil
+		}
+
+		return nil, err
+	}
+
+	plnRun, err := r.App.PipelineORM().FindRun(ctx, jobRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobRunRetried, map[string]any{"runID": args.ID, "jobRunID": jobRunID, "skipSucceeded": opts.SkipSucceeded})
+	return NewRetryJobRunPayload(&plnRun, r.App, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -1267,7 +1411,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -1295,7 +1439,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -21,13 +21,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceBridges, sessions.ActionView, bridgeScope(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -53,7 +54,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceBridges); err != nil {
 		return nil, err
 	}
 
@@ -74,7 +75,7 @@ func (r *Resolver) Chain(ctx context.Context,
 		ID      graphql.ID
 		Network *string
 	}) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -108,7 +109,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -160,7 +161,7 @@ func sortByNetworkAndID(chains []chainlink.NetworkChainStatus) {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -182,7 +183,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -196,7 +197,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView, r.jobScope(ctx, args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -227,7 +228,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -243,7 +244,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +257,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -270,7 +271,7 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 
 // Features retrieves each featured enabled by boolean mapping
 func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -279,7 +280,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 	r.App.GetLogger().Debug("resolver Node args %v", args)
@@ -307,7 +308,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -321,7 +322,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -337,7 +338,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -356,7 +357,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceFeeds); err != nil {
 		return nil, err
 	}
 
@@ -382,7 +383,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceChains); err != nil {
 		return nil, err
 	}
 
@@ -407,7 +408,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceJobs); err != nil {
 		return nil, err
 	}
 
@@ -425,7 +426,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorizeUser(ctx, sessions.ResourceJobs, sessions.ActionView, r.jobRunScope(ctx, args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -447,7 +448,7 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -505,7 +506,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -516,7 +517,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -537,7 +538,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -556,7 +557,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceTransactions); err != nil {
 		return nil, err
 	}
 
@@ -572,7 +573,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -582,7 +583,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -595,7 +596,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -608,7 +609,7 @@ func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, er
 }
 
 func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().Cosmos().GetAll()
@@ -620,7 +621,7 @@ func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver,
 }
 
 func (r *Resolver) SuiKeys(ctx context.Context) (*SuiKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -633,7 +634,7 @@ func (r *Resolver) SuiKeys(ctx context.Context) (*SuiKeysPayloadResolver, error)
 }
 
 func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().StarkNet().GetAll()
@@ -645,7 +646,7 @@ func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolv
 }
 
 func (r *Resolver) TronKeys(ctx context.Context) (*TronKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -658,7 +659,7 @@ func (r *Resolver) TronKeys(ctx context.Context) (*TronKeysPayloadResolver, erro
 }
 
 func (r *Resolver) TONKeys(ctx context.Context) (*TONKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
@@ -671,7 +672,7 @@ func (r *Resolver) TONKeys(ctx context.Context) (*TONKeysPayloadResolver, error)
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceConfig); err != nil {
 		return nil, err
 	}
 
@@ -682,7 +683,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanView(ctx, sessions.ResourceKeys); err != nil {
 		return nil, err
 	}
 
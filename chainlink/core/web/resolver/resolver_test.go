@@ -38,6 +38,7 @@ type mocks struct {
 	bridgeORM            *bridgeORMMocks.ORM
 	evmORM               *evmtest.TestConfigs
 	jobORM               *jobORMMocks.ORM
+	jobSpawner           *jobORMMocks.Spawner
 	authProvider         *authProviderMocks.AuthenticationProvider
 	pipelineORM          *pipelineMocks.ORM
 	feedsSvc             *feedsMocks.Service
@@ -99,6 +100,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		bridgeORM:            bridgeORMMocks.NewORM(t),
 		evmORM:               evmtest.NewTestConfigs(),
 		jobORM:               jobORMMocks.NewORM(t),
+		jobSpawner:           jobORMMocks.NewSpawner(t),
 		feedsSvc:             feedsMocks.NewService(t),
 		authProvider:         authProviderMocks.NewAuthenticationProvider(t),
 		pipelineORM:          pipelineMocks.NewORM(t),
@@ -164,6 +164,14 @@ func (r *SpecResolver) ToCRESettingsSpec() (*CRESettingsSpecResolver, bool) {
 	return &CRESettingsSpecResolver{spec: *r.j.CRESettingsSpec}, true
 }
 
+func (r *SpecResolver) ToLogTriggerSpec() (*LogTriggerSpecResolver, bool) {
+	if r.j.Type != job.LogTrigger {
+		return nil, false
+	}
+
+	return &LogTriggerSpecResolver{spec: *r.j.LogTriggerSpec}, true
+}
+
 type CronSpecResolver struct {
 	spec job.CronSpec
 }
@@ -1167,3 +1175,81 @@ func (r *CRESettingsSpecResolver) Settings() string {
 func (r *CRESettingsSpecResolver) Hash() string {
 	return r.spec.Hash
 }
+
+type LogTriggerSpecResolver struct {
+	spec job.LogTriggerSpec
+}
+
+// ContractAddress resolves the spec's contract address.
+func (r *LogTriggerSpecResolver) ContractAddress() string {
+	return r.spec.ContractAddress.String()
+}
+
+// EventABI resolves the spec's event ABI.
+func (r *LogTriggerSpecResolver) EventABI() string {
+	return r.spec.EventABI
+}
+
+// Topic1 resolves the spec's filter on the first indexed argument.
+func (r *LogTriggerSpecResolver) Topic1() []string {
+	return stringsOrEmpty(r.spec.Topic1)
+}
+
+// Topic2 resolves the spec's filter on the second indexed argument.
+func (r *LogTriggerSpecResolver) Topic2() []string {
+	return stringsOrEmpty(r.spec.Topic2)
+}
+
+// Topic3 resolves the spec's filter on the third indexed argument.
+func (r *LogTriggerSpecResolver) Topic3() []string {
+	return stringsOrEmpty(r.spec.Topic3)
+}
+
+// MinConfirmations resolves the spec's min confirmations.
+func (r *LogTriggerSpecResolver) MinConfirmations() int32 {
+	return int32(r.spec.MinConfirmations)
+}
+
+// FromBlock resolves the spec's from block.
+func (r *LogTriggerSpecResolver) FromBlock() string {
+	return strconv.FormatInt(r.spec.FromBlock, 10)
+}
+
+// PollPeriod resolves the spec's poll period.
+func (r *LogTriggerSpecResolver) PollPeriod() string {
+	return r.spec.PollPeriod.String()
+}
+
+// CursorBlock resolves the block from which the job resumes processing logs.
+func (r *LogTriggerSpecResolver) CursorBlock() *string {
+	if !r.spec.CursorBlock.Valid {
+		return nil
+	}
+
+	block := strconv.FormatInt(r.spec.CursorBlock.Int64, 10)
+
+	return &block
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *LogTriggerSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *LogTriggerSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+func stringsOrEmpty(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,146 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// RoleRequest is the request body for creating or updating a custom role.
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// RolesController manages the custom roles which can be assigned to local users.
+type RolesController struct {
+	App chainlink.Application
+}
+
+// Index lists the custom roles.
+func (rc *RolesController) Index(c *gin.Context) {
+	roles, err := rc.App.RolesORM().ListRoles(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResources(roles), "roles")
+}
+
+// Show returns the details of a custom role.
+func (rc *RolesController) Show(c *gin.Context) {
+	role, err := rc.App.RolesORM().FindRole(c.Request.Context(), c.Param("Name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Create adds a custom role.
+func (rc *RolesController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := RoleRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := sessions.ValidateRoleName(request.Name); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	permissions, err := sessions.ParsePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	orm := rc.App.RolesORM()
+	_, err = orm.FindRole(ctx, request.Name)
+	if err == nil {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("role %s already exists", request.Name))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	role := sessions.Role{Name: request.Name, Permissions: permissions}
+	if err = orm.CreateRole(ctx, &role); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]any{"name": role.Name, "permissions": role.Permissions.Strings()})
+
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Update replaces the permissions of a custom role.
+func (rc *RolesController) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := RoleRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	permissions, err := sessions.ParsePermissions(request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := rc.App.RolesORM().UpdateRolePermissions(ctx, c.Param("Name"), permissions)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]any{"name": role.Name, "permissions": role.Permissions.Strings()})
+
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
+
+// Destroy removes a custom role which is not assigned to any user.
+func (rc *RolesController) Destroy(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("Name")
+
+	orm := rc.App.RolesORM()
+	role, err := orm.FindRole(ctx, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("role not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for role: %w", err))
+		return
+	}
+	if err = orm.DeleteRole(ctx, name); err != nil {
+		if errors.Is(err, sessions.ErrRoleInUse) {
+			jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove role %s: %w", name, err))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete role: %w", err))
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]any{"name": name})
+
+	jsonAPIResponse(c, presenters.NewRoleResource(role), "role")
+}
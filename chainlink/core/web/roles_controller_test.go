@@ -0,0 +1,109 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestRolesController_CRUD(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid name",
+			reqBody:        `{"name": "bad name", "permissions": ["bridges:edit"]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "invalid role name",
+		},
+		{
+			name:           "Built-in name",
+			reqBody:        `{"name": "admin", "permissions": ["bridges:edit"]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "conflicts with a built-in role",
+		},
+		{
+			name:           "Invalid permission",
+			reqBody:        `{"name": "bridge-manager", "permissions": ["bridges:delete"]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "unknown action",
+		},
+		{
+			name:           "Success",
+			reqBody:        `{"name": "bridge-manager", "permissions": ["bridges:edit", "jobs:run:abc"]}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Duplicate",
+			reqBody:        `{"name": "bridge-manager", "permissions": ["bridges:view"]}`,
+			wantStatusCode: http.StatusConflict,
+			wantErrMessage: "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/roles", bytes.NewBufferString(tc.reqBody))
+			t.Cleanup(cleanup)
+
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+			if tc.wantErrMessage != "" {
+				errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+				require.Len(t, errors.Errors, 1)
+				assert.Contains(t, errors.Errors[0].Detail, tc.wantErrMessage)
+			}
+		})
+	}
+
+	resp, cleanup := client.Patch("/v2/roles/bridge-manager", bytes.NewBufferString(`{"permissions": ["bridges:view"]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/roles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var roles []presenters.RoleResource
+	cltest.ParseJSONAPIResponse(t, resp, &roles)
+	require.Len(t, roles, 1)
+	assert.Equal(t, "bridge-manager", roles[0].Name)
+	assert.Equal(t, []string{"bridges:view"}, roles[0].Permissions)
+
+	// a role assigned to a user can't be deleted
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &user))
+	resp, cleanup = client.Patch("/v2/users", bytes.NewBufferString(fmt.Sprintf(`{"email": "%s", "newRole": "bridge-manager"}`, user.Email)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Delete("/v2/roles/bridge-manager")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	require.NoError(t, app.AuthenticationProvider().DeleteUser(ctx, user.Email))
+	resp, cleanup = client.Delete("/v2/roles/bridge-manager")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/roles/bridge-manager")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
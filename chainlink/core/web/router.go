@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -179,7 +180,7 @@ func secureMiddleware(tlsRedirect bool, tlsHost string, devWebServer bool) gin.H
 }
 
 func debugRoutes(app chainlink.Application, r *gin.RouterGroup) {
-	group := r.Group("/debug", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
+	group := r.Group("/debug", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession), auth.RequiresViewPermission)
 	group.GET("/vars", expvar.Handler())
 }
 
@@ -241,21 +242,42 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	prc := PipelineRunsController{app}
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
+	unauthedv2.POST("/webhooks/:ID", prc.CreateSigned)
 
+	resolveScopes := auth.ResolveScopes(map[clsessions.Resource]auth.ScopeResolver{
+		clsessions.ResourceJobs: jobScopeResolver(app),
+	})
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), resolveScopes, auth.RequiresViewPermission)
 	{
 		uc := UserController{app}
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+
+		rolesc := RolesController{app}
+		authv2.GET("/roles", auth.RequiresAdminRole(rolesc.Index))
+		authv2.POST("/roles", auth.RequiresAdminRole(rolesc.Create))
+		authv2.GET("/roles/:Name", auth.RequiresAdminRole(rolesc.Show))
+		authv2.PATCH("/roles/:Name", auth.RequiresAdminRole(rolesc.Update))
+		authv2.DELETE("/roles/:Name", auth.RequiresAdminRole(rolesc.Destroy))
+
+		ac := AuditController{app}
+		authv2.GET("/audit", auth.RequiresAdminRole(paginatedRequest(ac.Index)))
+		authv2.GET("/audit/verify", auth.RequiresAdminRole(ac.Verify))
+
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		atc := APITokensController{app}
+		authv2.GET("/user/tokens", atc.Index)
+		authv2.POST("/user/tokens", atc.Create)
+		authv2.DELETE("/user/tokens/:name", atc.Destroy)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
@@ -272,6 +294,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
 		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
 
+		pf := PipelineFragmentsController{app}
+		authv2.GET("/pipeline_fragments", paginatedRequest(pf.Index))
+		authv2.POST("/pipeline_fragments", auth.RequiresEditRole(pf.Create))
+		authv2.GET("/pipeline_fragments/:Name", pf.Show)
+		authv2.DELETE("/pipeline_fragments/:Name", auth.RequiresEditRole(pf.Destroy))
+
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
 		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
@@ -385,17 +413,31 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		dkrkc := DKGRecipientKeysController{app}
 		authv2.GET("/keys/dkgrecipient", dkrkc.Index)
 
+		ksc := KeystoreController{app}
+		authv2.POST("/keystore/rotate-password", auth.RequiresAdminRole(ksc.RotatePassword))
+
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/validate", auth.RequiresEditRole(jc.Validate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
+		authv2.POST("/jobs/:ID/rollback", auth.RequiresEditRole(jc.Rollback))
+
+		jsrc := JobSpecRevisionsController{app}
+		authv2.GET("/jobs/:ID/revisions", jsrc.Index)
+		authv2.GET("/jobs/:ID/revisions/:revision", jsrc.Show)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.GET("/pipeline/runs/:runID/export", prc.Export)
+		authv2.POST("/pipeline/runs/replay", auth.RequiresRunRole(prc.Replay))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/:runID/retry", auth.RequiresRunRole(prc.Retry))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -444,7 +486,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), resolveScopes)
 	userOrEI.GET("/ping", ping.Show)
 	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -136,13 +137,18 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 		return
 	}
 	if request.NewRole == "" {
-		jsonAPIError(c, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view'"))
+		jsonAPIError(c, http.StatusBadRequest, errors.New("new-role flag is empty, must specify a new role, possible options are 'admin', 'edit', 'run', 'view' or a custom role"))
 		return
 	}
-	_, err := clsession.GetUserRole(request.NewRole)
-	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view'"))
-		return
+	if _, err := clsession.GetUserRole(request.NewRole); err != nil {
+		_, err = u.App.RolesORM().FindRole(ctx, request.NewRole)
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusBadRequest, errors.New("new role does not exist, possible options are 'admin', 'edit', 'run', 'view' or a custom role"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "error finding custom role"))
+			return
+		}
 	}
 
 	user, err := u.App.AuthenticationProvider().UpdateRole(ctx, request.Email, request.NewRole)